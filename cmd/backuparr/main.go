@@ -1,12 +1,13 @@
 package main
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"log"
+	"net/url"
 	"os"
 	"os/exec"
 	"strings"
@@ -15,27 +16,70 @@ import (
 
 	"backuparr/internal/backup"
 	"backuparr/internal/config"
+	"backuparr/internal/files"
+	"backuparr/internal/homeassistant"
+	"backuparr/internal/jellyfin"
+	"backuparr/internal/lidarr"
+	"backuparr/internal/metrics"
+	"backuparr/internal/mysql"
+	"backuparr/internal/notify"
+	"backuparr/internal/postgres"
 	"backuparr/internal/prowlarr"
 	"backuparr/internal/radarr"
+	"backuparr/internal/readarr"
 	"backuparr/internal/sidecar"
 	"backuparr/internal/sonarr"
 	"backuparr/internal/storage"
+	azurebackend "backuparr/internal/storage/azure"
+	"backuparr/internal/storage/encrypted"
+	gcsbackend "backuparr/internal/storage/gcs"
 	"backuparr/internal/storage/local"
+	pbsbackend "backuparr/internal/storage/pbs"
+	resticbackend "backuparr/internal/storage/restic"
 	s3backend "backuparr/internal/storage/s3"
+	sftpbackend "backuparr/internal/storage/sftp"
+	webdavbackend "backuparr/internal/storage/webdav"
 	"backuparr/internal/truenas"
 )
 
+// version is the backuparr build version recorded in backup manifests.
+// Set at build time with -ldflags "-X main.version=...".
+var version = "dev"
+
 // preflightCheck inspects the loaded config and verifies that all required
 // external tools are available before any work begins. This avoids partial
 // failures mid-backup or mid-restore due to a missing CLI tool.
 func preflightCheck(cfg config.BackuparrConfig) error {
-	var needPgDump, needPsql bool
+	var needPgDump, needPsql, needPgRestore, needMySQL, needRestic, needPBS bool
 
 	for _, app := range cfg.AppConfigs {
 		// If any app has an explicit postgres override, we'll need pg tools
-		if app.Postgres != nil {
+		// (the native engine talks to the server directly and needs none)
+		if app.Postgres != nil && app.Postgres.Engine != backup.EngineNative {
+			needPgDump = true
+			needPsql = true
+			if app.Postgres.Format == backup.DumpFormatCustom {
+				needPgRestore = true
+			}
+		}
+		if app.AppType == "postgres" && app.Database != nil && app.Database.Engine != backup.EngineNative {
 			needPgDump = true
 			needPsql = true
+			// The generic client defaults to custom format
+			if app.Database.Format != backup.DumpFormatPlain {
+				needPgRestore = true
+			}
+		}
+		if app.AppType == "mysql" {
+			needMySQL = true
+		}
+		for _, s := range app.Storage {
+			switch s.Type {
+			case "restic":
+				needRestic = true
+			case "pbs":
+				needPBS = true
+			}
 		}
 	}
 
@@ -51,6 +95,29 @@ func preflightCheck(cfg config.BackuparrConfig) error {
 			missing = append(missing, "psql (required for PostgreSQL restore)")
 		}
 	}
+	if needPgRestore {
+		if _, err := exec.LookPath("pg_restore"); err != nil {
+			missing = append(missing, "pg_restore (required for custom-format PostgreSQL restore)")
+		}
+	}
+
+	if needMySQL {
+		for _, tool := range []string{"mysqldump", "mysql"} {
+			if _, err := exec.LookPath(tool); err != nil {
+				missing = append(missing, tool+" (required for MySQL/MariaDB backup and restore)")
+			}
+		}
+	}
+	if needRestic {
+		if _, err := exec.LookPath("restic"); err != nil {
+			missing = append(missing, "restic (required for restic storage)")
+		}
+	}
+	if needPBS {
+		if _, err := exec.LookPath("proxmox-backup-client"); err != nil {
+			missing = append(missing, "proxmox-backup-client (required for pbs storage)")
+		}
+	}
 
 	if len(missing) > 0 {
 		return fmt.Errorf("missing required tools:\n  - %s", strings.Join(missing, "\n  - "))
@@ -69,6 +136,12 @@ func createClient(cfg config.AppConfig) (backup.Client, error) {
 			Password: cfg.Postgres.Password,
 			MainDB:   cfg.Postgres.MainDB,
 			LogDB:    cfg.Postgres.LogDB,
+			Format:   cfg.Postgres.Format,
+			Jobs:     cfg.Postgres.Jobs,
+			Engine:   cfg.Postgres.Engine,
+		}
+		if err := pgOverride.Validate(); err != nil {
+			return nil, err
 		}
 	}
 
@@ -77,8 +150,12 @@ func createClient(cfg config.AppConfig) (backup.Client, error) {
 		return sonarr.NewSonarrClient(cfg.Connection.URL, cfg.Connection.APIKey, cfg.Connection.Username, cfg.Connection.Password, pgOverride)
 	case "radarr":
 		return radarr.NewRadarrClient(cfg.Connection.URL, cfg.Connection.APIKey, cfg.Connection.Username, cfg.Connection.Password, pgOverride)
+	case "lidarr":
+		return lidarr.NewLidarrClient(cfg.Connection.URL, cfg.Connection.APIKey, cfg.Connection.Username, cfg.Connection.Password, pgOverride)
+	case "readarr":
+		return readarr.NewReadarrClient(cfg.Connection.URL, cfg.Connection.APIKey, cfg.Connection.Username, cfg.Connection.Password, pgOverride)
 	case "prowlarr":
-		return prowlarr.NewProwlarrClient(cfg.Connection.URL, cfg.Connection.APIKey, cfg.Connection.Username, cfg.Connection.Password)
+		return prowlarr.NewProwlarrClient(cfg.Connection.URL, cfg.Connection.APIKey, cfg.Connection.Username, cfg.Connection.Password, pgOverride)
 	case "truenas":
 		return truenas.NewClient(cfg.Connection.URL, cfg.Connection.APIKey), nil
 	case "sidecar":
@@ -87,6 +164,70 @@ func createClient(cfg config.AppConfig) (backup.Client, error) {
 			name = "sidecar"
 		}
 		return sidecar.NewClient(cfg.Connection.URL, cfg.Connection.APIKey, name)
+	case "files", "sqlite":
+		if cfg.Files == nil {
+			return nil, fmt.Errorf("appType %s requires a files.path", cfg.AppType)
+		}
+		name := cfg.Name
+		if name == "" {
+			name = cfg.AppType
+		}
+		return files.NewClient(name, cfg.Files.Path, cfg.Files.Exclude)
+	case "postgres", "mysql":
+		db := cfg.Database
+		if db == nil {
+			return nil, fmt.Errorf("appType %s requires a database section", cfg.AppType)
+		}
+		name := cfg.Name
+		if name == "" {
+			name = cfg.AppType
+		}
+		if cfg.AppType == "mysql" {
+			return mysql.NewClient(name, mysql.Config{Host: db.Host, Port: db.Port, User: db.User, Password: db.Password}, db.Databases)
+		}
+		return postgres.NewClient(name, &backup.PostgresConfig{
+			Host:     db.Host,
+			Port:     db.Port,
+			User:     db.User,
+			Password: db.Password,
+			Format:   db.Format,
+			Jobs:     db.Jobs,
+			Engine:   db.Engine,
+		}, db.Databases)
+	case "jellyfin", "emby":
+		if cfg.Jellyfin == nil {
+			return nil, fmt.Errorf("appType %s requires a jellyfin section", cfg.AppType)
+		}
+		name := cfg.Name
+		if name == "" {
+			name = cfg.AppType
+		}
+		return jellyfin.NewClient(name, jellyfin.Config{
+			URL:        cfg.Connection.URL,
+			APIKey:     cfg.Connection.APIKey,
+			Emby:       cfg.AppType == "emby",
+			BackupPath: cfg.Jellyfin.BackupPath,
+			DataPath:   cfg.Jellyfin.DataPath,
+			Excludes:   cfg.Jellyfin.Exclude,
+			Metadata:   cfg.Jellyfin.Metadata,
+			Subtitles:  cfg.Jellyfin.Subtitles,
+			Trickplay:  cfg.Jellyfin.Trickplay,
+		})
+	case "homeassistant":
+		var opts homeassistant.Options
+		if ha := cfg.HomeAssistant; ha != nil {
+			opts = homeassistant.Options{
+				Password:           ha.Password,
+				Addons:             ha.Addons,
+				Folders:            ha.Folders,
+				InsecureSkipVerify: ha.InsecureSkipVerify,
+			}
+		}
+		name := cfg.Name
+		if name == "" {
+			name = cfg.AppType
+		}
+		return homeassistant.NewClient(name, cfg.Connection.URL, cfg.Connection.APIKey, opts)
 	default:
 		return nil, fmt.Errorf("unsupported app type: %s", cfg.AppType)
 	}
@@ -127,10 +268,110 @@ func createBackends(configs []config.StorageConfig) ([]storage.Backend, error) {
 			if err != nil {
 				return nil, fmt.Errorf("failed to create S3 backend: %w", err)
 			}
+		case "sftp":
+			var err error
+			b, err = sftpbackend.New(sftpbackend.Config{
+				Host:                 cfg.Host,
+				Port:                 cfg.Port,
+				User:                 cfg.User,
+				Path:                 cfg.Path,
+				Password:             cfg.Password,
+				PrivateKeyFile:       cfg.PrivateKeyFile,
+				PrivateKeyPassphrase: cfg.PrivateKeyPassphrase,
+				KnownHostsFile:       cfg.KnownHostsFile,
+				HostKey:              cfg.HostKey,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create SFTP backend: %w", err)
+			}
+		case "webdav":
+			var err error
+			b, err = webdavbackend.New(webdavbackend.Config{
+				URL:      cfg.URL,
+				Path:     cfg.Path,
+				User:     cfg.User,
+				Password: cfg.Password,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create WebDAV backend: %w", err)
+			}
+		case "azure":
+			var err error
+			b, err = azurebackend.New(azurebackend.Config{
+				Container:        cfg.Container,
+				Prefix:           cfg.Prefix,
+				AccessTier:       cfg.AccessTier,
+				ConnectionString: cfg.ConnectionString,
+				Account:          cfg.Account,
+				AccountKey:       cfg.AccountKey,
+				SASToken:         cfg.SASToken,
+				Endpoint:         cfg.Endpoint,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create Azure backend: %w", err)
+			}
+		case "gcs":
+			var err error
+			b, err = gcsbackend.New(context.Background(), gcsbackend.Config{
+				Bucket:          cfg.Bucket,
+				Prefix:          cfg.Prefix,
+				StorageClass:    cfg.StorageClass,
+				CredentialsFile: cfg.CredentialsFile,
+				CredentialsJSON: cfg.CredentialsJSON,
+				Endpoint:        cfg.Endpoint,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create GCS backend: %w", err)
+			}
+		case "restic":
+			// restic encrypts the repository itself, and its snapshot IDs
+			// don't carry the .age suffix the encrypted wrapper relies on.
+			if cfg.Encryption != nil {
+				return nil, fmt.Errorf("storage %s: encryption is not supported for restic, the repository is already encrypted", config.StorageConfigName(cfg))
+			}
+			var err error
+			b, err = resticbackend.New(resticbackend.Config{
+				Repository:   cfg.Repository,
+				Password:     cfg.Password,
+				PasswordFile: cfg.PasswordFile,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create restic backend: %w", err)
+			}
+		case "pbs":
+			// Keys are snapshot paths without the .age suffix the encrypted
+			// wrapper relies on, and age output would defeat PBS dedup.
+			if cfg.Encryption != nil {
+				return nil, fmt.Errorf("storage %s: encryption is not supported for pbs storage", config.StorageConfigName(cfg))
+			}
+			var err error
+			b, err = pbsbackend.New(pbsbackend.Config{
+				Host:        cfg.Host,
+				Port:        cfg.Port,
+				User:        cfg.User,
+				Password:    cfg.Password,
+				Datastore:   cfg.Datastore,
+				Namespace:   cfg.Namespace,
+				Fingerprint: cfg.Fingerprint,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create PBS backend: %w", err)
+			}
 		default:
 			return nil, fmt.Errorf("unsupported storage type: %s", cfg.Type)
 		}
 		b.SetName(config.StorageConfigName(cfg))
+		if enc := cfg.Encryption; enc != nil {
+			var err error
+			b, err = encrypted.New(b, encrypted.Config{
+				Passphrase:   enc.Passphrase,
+				Recipients:   enc.Recipients,
+				IdentityFile: enc.IdentityFile,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("storage %s: %w", config.StorageConfigName(cfg), err)
+			}
+		}
 		backends = append(backends, b)
 	}
 	return backends, nil
@@ -147,48 +388,145 @@ func toStorageRetention(r config.RetentionPolicy) storage.RetentionPolicy {
 	}
 }
 
-func runBackup(ctx context.Context, app backup.Client, backends []storage.Backend, retention config.RetentionPolicy) error {
+func runBackup(ctx context.Context, app backup.Client, backends []storage.Backend, appCfg config.AppConfig) (err error) {
 	log.Printf("[%s] Starting backup...", app.Name())
 
+	start := time.Now()
+	var size int64
+	defer func() {
+		metrics.ObserveBackup(app.Name(), time.Since(start), size, err == nil)
+	}()
+
 	result, reader, err := app.Backup(ctx)
 	if err != nil {
 		return fmt.Errorf("backup failed: %w", err)
 	}
 	defer reader.Close()
 
-	// Read backup into memory (needed for uploading to multiple backends)
-	data, err := io.ReadAll(reader)
-	if err != nil {
-		return fmt.Errorf("failed to read backup data: %w", err)
+	if result.Size > 0 {
+		log.Printf("[%s] Backup created: %s (%d bytes)", app.Name(), result.Name, result.Size)
+	} else {
+		log.Printf("[%s] Backup created: %s (streaming)", app.Name(), result.Name)
 	}
 
-	log.Printf("[%s] Backup created: %s (%d bytes)", app.Name(), result.Name, len(data))
-
 	// Generate consistent filename
-	fileName := storage.FormatBackupName(app.Name(), time.Now())
-
-	// Upload to each backend sequentially
-	for _, backend := range backends {
-		meta, err := backend.Upload(ctx, app.Name(), fileName, bytes.NewReader(data), int64(len(data)))
-		if err != nil {
-			log.Printf("[%s] Failed to upload to %s: %v", app.Name(), backend.Name(), err)
+	createdAt := time.Now().UTC()
+	fileName := storage.FormatBackupName(app.Name(), createdAt)
+
+	// Stream the backup to every backend concurrently. The backup is read
+	// once and never held in memory in full.
+	// The archive is hashed on the way through for the manifest.
+	hasher := storage.NewHasher(reader)
+	results := storage.UploadAll(ctx, backends, app.Name(), fileName, hasher, result.Size)
+
+	manifest := storage.Manifest{
+		AppName:          app.Name(),
+		AppType:          appCfg.AppType,
+		AppVersion:       result.AppVersion,
+		DBType:           result.DBType,
+		CreatedAt:        createdAt,
+		Size:             hasher.Size(),
+		SHA256:           hasher.Sum(),
+		BackuparrVersion: version,
+		SourceURL:        redactURL(appCfg.Connection.URL),
+	}
+
+	uploaded := 0
+	var firstErr error
+	for _, res := range results {
+		backend := res.Backend
+		if res.Err != nil {
+			metrics.UploadFailed(app.Name(), backend.Name())
+			log.Printf("[%s] Failed to upload to %s: %v", app.Name(), backend.Name(), res.Err)
+			if firstErr == nil {
+				firstErr = res.Err
+			}
 			continue
 		}
-		log.Printf("[%s] Uploaded to %s: %s (%d bytes)", app.Name(), backend.Name(), meta.FileName, meta.Size)
+		uploaded++
+		metrics.UploadSucceeded(app.Name(), backend.Name(), createdAt)
+		log.Printf("[%s] Uploaded to %s: %s (%d bytes)", app.Name(), backend.Name(), res.Meta.FileName, res.Meta.Size)
+
+		if err := storage.WriteManifest(ctx, backend, res.Meta, manifest); err != nil {
+			log.Printf("[%s] Failed to write manifest to %s: %v", app.Name(), backend.Name(), err)
+		}
 
 		// Apply retention policy
-		storageRetention := toStorageRetention(retention)
+		storageRetention := toStorageRetention(appCfg.Retention)
 		deleted, err := storage.ApplyRetention(ctx, backend, app.Name(), storageRetention)
 		if err != nil {
 			log.Printf("[%s] Retention cleanup failed on %s: %v", app.Name(), backend.Name(), err)
 		} else if deleted > 0 {
+			metrics.RetentionDeleted(app.Name(), backend.Name(), deleted)
 			log.Printf("[%s] Cleaned up %d old backup(s) from %s", app.Name(), deleted, backend.Name())
 		}
 	}
 
+	// With streaming, a failure reading the backup surfaces as an upload
+	// error on every backend, so treat "nothing stored" as a failed backup.
+	if uploaded == 0 && firstErr != nil {
+		return fmt.Errorf("failed to store backup on any backend: %w", firstErr)
+	}
+
+	// Only now is it safe to drop anything the backup left on the app.
+	if c, ok := app.(backup.Cleaner); ok && uploaded > 0 {
+		if err := c.Cleanup(ctx, result); err != nil {
+			log.Printf("[%s] Warning: %v", app.Name(), err)
+		}
+	}
+
+	size = hasher.Size()
 	return nil
 }
 
+// redactURL strips any credentials from a URL before it is recorded.
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.User == nil {
+		return raw
+	}
+	u.User = nil
+	return u.String()
+}
+
+// redactSecrets removes the app's configured credentials from msg, an
+// error that is about to be sent in a notification.
+func redactSecrets(msg string, appCfg config.AppConfig) string {
+	secrets := []string{appCfg.Connection.APIKey, appCfg.Connection.Password, urlPassword(appCfg.Connection.URL)}
+	if appCfg.Postgres != nil {
+		secrets = append(secrets, appCfg.Postgres.Password)
+	}
+	if appCfg.Database != nil {
+		secrets = append(secrets, appCfg.Database.Password)
+	}
+	if appCfg.HomeAssistant != nil {
+		secrets = append(secrets, appCfg.HomeAssistant.Password)
+	}
+	for _, s := range appCfg.Storage {
+		secrets = append(secrets, s.SecretAccessKey, s.Password, s.PrivateKeyPassphrase,
+			s.ConnectionString, s.AccountKey, s.SASToken, s.CredentialsJSON, urlPassword(s.URL))
+		if s.Encryption != nil {
+			secrets = append(secrets, s.Encryption.Passphrase)
+		}
+	}
+	for _, secret := range secrets {
+		if secret != "" {
+			msg = strings.ReplaceAll(msg, secret, "[redacted]")
+		}
+	}
+	return msg
+}
+
+// urlPassword returns the password embedded in a URL, if any.
+func urlPassword(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.User == nil {
+		return ""
+	}
+	p, _ := u.User.Password()
+	return p
+}
+
 func main() {
 	if len(os.Args) < 2 {
 		// Default to backup when no subcommand
@@ -203,8 +541,12 @@ func main() {
 		runRestoreCLI()
 	case "list":
 		runListCLI()
-	case "web", "serve":
+	case "verify":
+		runVerifyCLI()
+	case "web", "serve", "daemon":
 		runWebUI()
+	case "hash-password":
+		runHashPasswordCLI()
 	case "help", "--help", "-h":
 		printUsage()
 	default:
@@ -219,18 +561,38 @@ Commands:
   backup                  Run backups for all configured apps (default)
   restore                 Restore an app from a storage backend
   list                    List available backups from a storage backend
+  verify                  Check stored backups for corruption without restoring
 	web                     Start web UI for listing/deleting backups
+  daemon                  Start web UI and run backups on each app's schedule
+  hash-password           Read a password from stdin and print its bcrypt hash for web.auth.users
   help                    Show this help message
 
 Restore flags:
-  --app <name>            App to restore (e.g. sonarr, radarr, prowlarr, truenas) [required]
+  --app <name>            App to restore (e.g. sonarr, radarr, lidarr, prowlarr, truenas) [required]
   --backend <name>        Storage backend name (defaults to type, e.g. local, s3) [required]
   --backup <key>          Specific backup key to restore
   --latest                Restore the most recent backup
+  --dry-run               Report what would change (databases dropped, files written, restart) without restoring
+  --pg-host <host>        Restore Postgres dumps to another host (config.xml is rewritten to match)
+  --pg-port <port>        Restore Postgres dumps to another port
+  --pg-main-db <name>     Restore the main database dump into another database
+  --pg-log-db <name>      Restore the log database dump into another database
 
 List flags:
-  --app <name>            App to list backups for (e.g. sonarr, radarr, prowlarr, truenas) [required]
+  --app <name>            App to list backups for (e.g. sonarr, radarr, lidarr, prowlarr, truenas) [required]
+  --backend <name>        Storage backend name (defaults to type, e.g. local, s3) [required]
+
+Verify flags:
+  --app <name>            App to verify backups for [required]
   --backend <name>        Storage backend name (defaults to type, e.g. local, s3) [required]
+  --backup <key>          Specific backup key to verify
+  --latest                Verify the most recent backup
+  --all                   Verify every backup on the backend
+
+Web/daemon flags:
+  --listen <addr>         HTTP listen address (default :8080)
+  --config <path>         Path to config file (overrides BACKUPARR_CONFIG)
+  --schedule              Run scheduled backups (default true for daemon)
 
 Environment:
   BACKUPARR_CONFIG        Path to config file (default: /config/config.yml)
@@ -239,10 +601,15 @@ Examples:
   backuparr                                           # Run backups
   backuparr backup                                    # Run backups (explicit)
   backuparr list --app sonarr --backend local         # List sonarr backups
+  backuparr verify --app sonarr --backend s3 --latest # Check the newest backup
   backuparr restore --app sonarr --backend s3 --latest
+  backuparr restore --app sonarr --backend s3 --latest --dry-run
   backuparr restore --app radarr --backend nas --latest  # Named backend
   backuparr restore --app sonarr --backend local --backup "sonarr/sonarr_2026-02-06T120000Z.zip"
+  backuparr restore --app sonarr --backend s3 --latest --pg-main-db sonarr-main-staging --pg-log-db sonarr-log-staging
 	backuparr web --listen :8080 --config ./config.yml # Start web UI
+  backuparr daemon --listen :8080                     # Web UI + scheduled backups
+  backuparr hash-password < password.txt              # Hash a web UI password
 
 Docker:
   docker run -v /path/to/config.yml:/config/config.yml backuparr backup
@@ -257,7 +624,25 @@ func runBackupAll() {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
+	notifier, err := notify.New(cfg.Notifications)
+	if err != nil {
+		log.Fatalf("Invalid notifications config: %v", err)
+	}
+
+	event := notify.Event{Kind: "backup", Trigger: "cli", Success: true, StartedAt: time.Now().UTC()}
+	event.Host, _ = os.Hostname()
+	send := func() {
+		event.EndedAt = time.Now().UTC()
+		notifier.Send(ctx, event)
+	}
+	fail := func(app, msg string) {
+		event.Success = false
+		event.Results = append(event.Results, notify.Result{App: app, Status: "failed", Error: msg})
+	}
+
 	if err := preflightCheck(cfg); err != nil {
+		fail("preflight", err.Error())
+		send()
 		log.Fatalf("Preflight check failed: %v", err)
 	}
 
@@ -265,19 +650,59 @@ func runBackupAll() {
 		client, err := createClient(appCfg)
 		if err != nil {
 			log.Printf("Failed to create client for %s: %v", appCfg.AppType, err)
+			fail(appCfg.AppType, "failed to create app client: "+redactSecrets(err.Error(), appCfg))
 			continue
 		}
 
 		backends, err := createBackends(appCfg.Storage)
 		if err != nil {
 			log.Printf("[%s] Failed to create storage backends: %v", appCfg.AppType, err)
+			fail(appCfg.AppType, "failed to create storage backends: "+redactSecrets(err.Error(), appCfg))
 			continue
 		}
 
-		if err := runBackup(ctx, client, backends, appCfg.Retention); err != nil {
+		if err := runBackup(ctx, client, backends, appCfg); err != nil {
 			log.Printf("[%s] Backup failed: %v", appCfg.AppType, err)
+			fail(client.Name(), err.Error())
+			continue
 		}
+		event.Results = append(event.Results, notify.Result{App: client.Name(), OK: true, Status: "ok"})
 	}
+
+	send()
+}
+
+// restoreBackup downloads key from backend and hands it to client.Restore.
+// The download is verified against the backup's manifest while the client
+// reads it. Every client reads the whole backup before acting on it, so a
+// mismatch aborts the restore before anything is changed.
+func restoreBackup(ctx context.Context, client backup.Client, backend storage.Backend, key string, opts backup.RestoreOptions) (*backup.RestorePlan, error) {
+	log.Printf("Downloading backup %s from %s...", key, backend.Name())
+	reader, meta, err := backend.Download(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download backup: %w", err)
+	}
+	defer reader.Close()
+
+	log.Printf("Downloaded: %s (%d bytes, created %s)", meta.FileName, meta.Size, meta.CreatedAt.Format(time.RFC3339))
+
+	var data io.Reader = reader
+	m, err := storage.ReadManifest(ctx, backend, key)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		log.Printf("Warning: no manifest for %s, skipping checksum verification", key)
+	case err != nil:
+		return nil, fmt.Errorf("failed to read manifest, refusing to restore unverified backup: %w", err)
+	default:
+		log.Printf("Manifest: %s %s, sha256 %s", m.AppType, m.AppVersion, m.SHA256)
+		data = storage.NewVerifyingReader(reader, m)
+	}
+
+	plan, err := client.Restore(ctx, data, opts)
+	if !opts.DryRun {
+		metrics.ObserveRestore(client.Name(), err == nil)
+	}
+	return plan, err
 }
 
 func runRestoreCLI() {
@@ -286,6 +711,11 @@ func runRestoreCLI() {
 	backendName := fs.String("backend", "", "Storage backend name (e.g. local, s3, nas)")
 	backupKey := fs.String("backup", "", "Specific backup key to restore")
 	latest := fs.Bool("latest", false, "Restore the most recent backup")
+	dryRun := fs.Bool("dry-run", false, "Report what the restore would change without changing anything")
+	pgHost := fs.String("pg-host", "", "Restore Postgres dumps to this host instead of the one in config.xml")
+	pgPort := fs.String("pg-port", "", "Restore Postgres dumps to this port instead of the one in config.xml")
+	pgMainDB := fs.String("pg-main-db", "", "Restore the main database dump into this database")
+	pgLogDB := fs.String("pg-log-db", "", "Restore the log database dump into this database")
 	fs.Parse(os.Args[2:])
 
 	if *appName == "" || *backendName == "" {
@@ -299,6 +729,16 @@ func runRestoreCLI() {
 		os.Exit(1)
 	}
 
+	var opts backup.RestoreOptions
+	if *pgHost != "" || *pgPort != "" || *pgMainDB != "" || *pgLogDB != "" {
+		opts.Postgres = &backup.PostgresConfig{
+			Host:   *pgHost,
+			Port:   *pgPort,
+			MainDB: *pgMainDB,
+			LogDB:  *pgLogDB,
+		}
+	}
+
 	ctx := context.Background()
 
 	cfg, err := config.Parse(config.Path())
@@ -342,19 +782,19 @@ func runRestoreCLI() {
 		log.Printf("Selected latest backup: %s (created %s)", key, backups[0].CreatedAt.Format(time.RFC3339))
 	}
 
-	// Download the backup
-	log.Printf("Downloading backup %s from %s...", key, backend.Name())
-	reader, meta, err := backend.Download(ctx, key)
-	if err != nil {
-		log.Fatalf("Failed to download backup: %v", err)
+	if *dryRun {
+		log.Printf("Dry run: analysing %s restore (nothing will be changed)...", *appName)
+		opts.DryRun = true
+		plan, err := restoreBackup(ctx, client, backend, key, opts)
+		if err != nil {
+			log.Fatalf("Dry run failed: %v", err)
+		}
+		fmt.Printf("Restoring %s from %s would:\n%s", key, backend.Name(), plan)
+		return
 	}
-	defer reader.Close()
-
-	log.Printf("Downloaded: %s (%d bytes, created %s)", meta.FileName, meta.Size, meta.CreatedAt.Format(time.RFC3339))
 
-	// Restore
 	log.Printf("Restoring %s...", *appName)
-	if err := client.Restore(ctx, reader); err != nil {
+	if _, err := restoreBackup(ctx, client, backend, key, opts); err != nil {
 		log.Fatalf("Restore failed: %v", err)
 	}
 
@@ -405,10 +845,14 @@ func runListCLI() {
 
 	// Print as a formatted table
 	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-	fmt.Fprintf(w, "KEY\tFILENAME\tSIZE\tCREATED\n")
+	fmt.Fprintf(w, "KEY\tFILENAME\tSIZE\tCREATED\tENCRYPTED\n")
 	for _, b := range backups {
 		sizeStr := formatSize(b.Size)
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", b.Key, b.FileName, sizeStr, b.CreatedAt.Format(time.RFC3339))
+		encStr := "no"
+		if b.Encrypted {
+			encStr = "yes"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", b.Key, b.FileName, sizeStr, b.CreatedAt.Format(time.RFC3339), encStr)
 	}
 	w.Flush()
 }
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"backuparr/internal/backup"
+	"backuparr/internal/config"
+	"backuparr/internal/storage"
+	"backuparr/internal/storage/local"
+)
+
+// recordingClient is a backup.Client that records whether Restore ran.
+type recordingClient struct {
+	restored bool
+}
+
+func (c *recordingClient) Name() string { return "sonarr" }
+
+func (c *recordingClient) Backup(ctx context.Context) (*backup.BackupResult, io.ReadCloser, error) {
+	return nil, nil, errors.New("not implemented")
+}
+
+func (c *recordingClient) Restore(ctx context.Context, r io.Reader, opts backup.RestoreOptions) (*backup.RestorePlan, error) {
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		return nil, err
+	}
+	c.restored = true
+	return &backup.RestorePlan{App: "sonarr"}, nil
+}
+
+// brokenManifestBackend fails to read manifests with an error other than
+// storage.ErrNotFound, as a network or auth failure would.
+type brokenManifestBackend struct {
+	storage.Backend
+}
+
+func (b brokenManifestBackend) Download(ctx context.Context, key string) (io.ReadCloser, *storage.BackupMetadata, error) {
+	if storage.IsManifestName(key) {
+		return nil, nil, errors.New("connection reset by peer")
+	}
+	return b.Backend.Download(ctx, key)
+}
+
+func TestRestoreBackup_Manifest(t *testing.T) {
+	ctx := context.Background()
+	backend := local.New(t.TempDir())
+	meta, err := backend.Upload(ctx, "sonarr", "sonarr_2026-02-06T120000Z.zip", strings.NewReader("backup"), 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A backup without a manifest predates them and restores unverified.
+	client := &recordingClient{}
+	if _, err := restoreBackup(ctx, client, backend, meta.Key, backup.RestoreOptions{}); err != nil || !client.restored {
+		t.Fatalf("legacy backup: restored = %v, err = %v", client.restored, err)
+	}
+
+	// A manifest that exists but can't be read must not be skipped.
+	if err := os.WriteFile(filepath.Join(filepath.Dir(meta.Key), meta.FileName+storage.ManifestSuffix), []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for name, b := range map[string]storage.Backend{
+		"corrupt manifest":    backend,
+		"unreadable manifest": brokenManifestBackend{backend},
+	} {
+		client := &recordingClient{}
+		if _, err := restoreBackup(ctx, client, b, meta.Key, backup.RestoreOptions{}); err == nil || client.restored {
+			t.Errorf("%s: restored = %v, err = %v", name, client.restored, err)
+		}
+	}
+}
+
+// cleaningClient is a backup.Client and backup.Cleaner that records
+// whether Cleanup ran.
+type cleaningClient struct {
+	recordingClient
+	cleaned bool
+}
+
+func (c *cleaningClient) Backup(ctx context.Context) (*backup.BackupResult, io.ReadCloser, error) {
+	return &backup.BackupResult{Name: "backup.zip"}, io.NopCloser(strings.NewReader("backup")), nil
+}
+
+func (c *cleaningClient) Cleanup(ctx context.Context, result *backup.BackupResult) error {
+	c.cleaned = true
+	return nil
+}
+
+// failingBackend reads the whole upload and then fails, as a failed
+// multipart completion or rename would.
+type failingBackend struct {
+	storage.Backend
+}
+
+func (b failingBackend) Upload(ctx context.Context, appName, fileName string, data io.Reader, size int64) (*storage.BackupMetadata, error) {
+	if _, err := io.Copy(io.Discard, data); err != nil {
+		return nil, err
+	}
+	return nil, errors.New("upload failed")
+}
+
+func TestRunBackup_Cleanup(t *testing.T) {
+	ctx := context.Background()
+	appCfg := config.AppConfig{AppType: "sonarr"}
+
+	// Nothing may be cleaned up on the app when no backend holds the backup.
+	client := &cleaningClient{}
+	backends := []storage.Backend{failingBackend{local.New(t.TempDir())}}
+	if err := runBackup(ctx, client, backends, appCfg); err == nil || client.cleaned {
+		t.Fatalf("failed upload: cleaned = %v, err = %v", client.cleaned, err)
+	}
+
+	client = &cleaningClient{}
+	backends = append(backends, local.New(t.TempDir()))
+	if err := runBackup(ctx, client, backends, appCfg); err != nil || !client.cleaned {
+		t.Fatalf("partial upload: cleaned = %v, err = %v", client.cleaned, err)
+	}
+}
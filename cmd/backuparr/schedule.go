@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"backuparr/internal/config"
+	"backuparr/internal/scheduler"
+)
+
+// newScheduler builds a scheduler for every app in the config that has a
+// schedule. Scheduled runs are recorded as backup jobs on s so they show up
+// in the web UI alongside manually triggered ones.
+func newScheduler(s *webServer) (*scheduler.Scheduler, error) {
+	jitter, err := s.cfg.Scheduler.JitterDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	sched := scheduler.New(scheduler.Options{
+		Jitter:  jitter,
+		CatchUp: s.cfg.Scheduler.CatchUpEnabled(),
+		LastRun: func(ctx context.Context, app string) (time.Time, error) {
+			appCfg, err := findAppConfig(s.cfg, app)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return lastBackupTime(ctx, appCfg)
+		},
+	}, s.runScheduledBackup)
+
+	for _, appCfg := range s.cfg.AppConfigs {
+		if appCfg.Schedule == "" {
+			continue
+		}
+		name := appCfg.Name
+		if name == "" {
+			name = appCfg.AppType
+		}
+		if err := sched.Add(name, appCfg.Schedule); err != nil {
+			return nil, fmt.Errorf("[%s] %w", name, err)
+		}
+	}
+
+	if len(sched.Entries()) == 0 {
+		log.Printf("Warning: scheduler enabled but no app has a schedule configured")
+	}
+
+	return sched, nil
+}
+
+// lastBackupTime returns the creation time of the newest backup for an app
+// across all of its configured backends. Backends that fail to list are
+// skipped; an error is returned only if none could be listed.
+func lastBackupTime(ctx context.Context, appCfg config.AppConfig) (time.Time, error) {
+	name := appCfg.Name
+	if name == "" {
+		name = appCfg.AppType
+	}
+
+	backends, err := createBackends(appCfg.Storage)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var latest time.Time
+	var lastErr error
+	listed := 0
+	for _, backend := range backends {
+		backups, err := backend.List(ctx, name)
+		if err != nil {
+			lastErr = fmt.Errorf("list %s: %w", backend.Name(), err)
+			continue
+		}
+		listed++
+		if len(backups) > 0 && backups[0].CreatedAt.After(latest) {
+			latest = backups[0].CreatedAt
+		}
+	}
+
+	if listed == 0 && lastErr != nil {
+		return time.Time{}, lastErr
+	}
+	return latest, nil
+}
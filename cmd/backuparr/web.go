@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bufio"
 	"context"
 	"embed"
 	"encoding/json"
@@ -12,29 +13,51 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
+	"backuparr/internal/backup"
 	"backuparr/internal/config"
+	"backuparr/internal/metrics"
+	"backuparr/internal/notify"
+	"backuparr/internal/scheduler"
 	"backuparr/internal/storage"
+	"backuparr/internal/webauth"
 	"github.com/gorilla/websocket"
+	"golang.org/x/crypto/bcrypt"
 )
 
 //go:embed webui/*
 var webUIFS embed.FS
 
-var wsUpgrader = websocket.Upgrader{
-	ReadBufferSize:  1024,
-	WriteBufferSize: 1024,
-	CheckOrigin: func(r *http.Request) bool {
-		return true
-	},
-}
-
 var logCaptureMu sync.Mutex
 
+// maxFinishedJobs bounds how many completed jobs are kept in memory. The
+// daemon runs indefinitely, so without a cap scheduled jobs would accumulate.
+const maxFinishedJobs = 100
+
+const (
+	triggerManual   = "manual"
+	triggerSchedule = "schedule"
+)
+
+// Job kinds. Backups and restores share the job table, status endpoints and
+// websocket log stream.
+const (
+	jobKindBackup  = "backup"
+	jobKindRestore = "restore"
+)
+
+// errJobRunning is returned when a backup or restore is requested for an
+// app that already has a backup or restore in progress.
+var errJobRunning = errors.New("a job for this app is already running")
+
 type jobLogWriter struct {
 	server *webServer
 	jobID  string
@@ -53,16 +76,23 @@ func (w jobLogWriter) Write(p []byte) (int, error) {
 }
 
 type webServer struct {
-	cfg  config.BackuparrConfig
-	mu   sync.RWMutex
-	jobs map[string]*backupJob
+	cfg       config.BackuparrConfig
+	auth      *webauth.Authenticator
+	upgrader  websocket.Upgrader
+	notifier  *notify.Dispatcher // nil sends nothing
+	mu        sync.RWMutex
+	jobs      map[string]*backupJob
+	lastJobID atomic.Uint64        // job IDs are allocated sequentially
+	scheduler *scheduler.Scheduler // nil unless running as a daemon
 }
 
 type appOption struct {
-	Name      string          `json:"name"`
-	AppType   string          `json:"appType"`
-	Backends  []string        `json:"backends"`
-	Retention retentionInfo   `json:"retention"`
+	Name      string        `json:"name"`
+	AppType   string        `json:"appType"`
+	Backends  []string      `json:"backends"`
+	Retention retentionInfo `json:"retention"`
+	Schedule  string        `json:"schedule,omitempty"`
+	NextRun   *time.Time    `json:"nextRun,omitempty"`
 }
 
 type retentionInfo struct {
@@ -90,8 +120,25 @@ type triggerBackupResult struct {
 	Status string `json:"status"`
 }
 
+// restoreRequest is the body of POST /api/restore. Confirm must repeat the
+// app name unless DryRun is set.
+type restoreRequest struct {
+	App     string `json:"app"`
+	Backend string `json:"backend"`
+	Key     string `json:"key"`
+	Confirm string `json:"confirm,omitempty"`
+	DryRun  bool   `json:"dryRun,omitempty"`
+}
+
 type triggerBackupResponse struct {
 	JobID     string                `json:"jobId,omitempty"`
+	Kind      string                `json:"kind"`
+	Trigger   string                `json:"trigger"`
+	App       string                `json:"app,omitempty"`
+	Backend   string                `json:"backend,omitempty"`
+	Key       string                `json:"key,omitempty"`
+	DryRun    bool                  `json:"dryRun,omitempty"`
+	Plan      *backup.RestorePlan   `json:"plan,omitempty"`
 	Running   bool                  `json:"running"`
 	Success   *bool                 `json:"success,omitempty"`
 	Status    string                `json:"status"`
@@ -101,21 +148,32 @@ type triggerBackupResponse struct {
 	EndedAt   *time.Time            `json:"endedAt,omitempty"`
 }
 
+type jobsResponse struct {
+	Jobs []triggerBackupResponse `json:"jobs"`
+}
+
 type backupJob struct {
 	ID        string
+	Kind      string
+	Trigger   string
 	StartedAt time.Time
 	EndedAt   *time.Time
 	Running   bool
 	Success   *bool
 	Request   triggerBackupRequest
+	Restore   *restoreRequest     // set for restore jobs
+	Plan      *backup.RestorePlan // set once a restore job finishes
 	Results   []triggerBackupResult
 	Logs      []string
 }
 
+// runWebUI serves the web UI. When invoked as `backuparr daemon` (or with
+// --schedule) it also runs backups for every app that has a schedule.
 func runWebUI() {
-	fs := flag.NewFlagSet("web", flag.ExitOnError)
+	fs := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
 	listen := fs.String("listen", ":8080", "HTTP listen address")
 	configPath := fs.String("config", "", "Path to config file (overrides BACKUPARR_CONFIG)")
+	schedule := fs.Bool("schedule", os.Args[1] == "daemon", "Run backups on each app's configured schedule")
 	fs.Parse(os.Args[2:])
 
 	path := config.Path()
@@ -128,29 +186,136 @@ func runWebUI() {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
-	s := &webServer{cfg: cfg, jobs: map[string]*backupJob{}}
-	mux := http.NewServeMux()
-	mux.HandleFunc("/api/apps", s.handleApps)
-	mux.HandleFunc("/api/backups", s.handleBackups)
-	mux.HandleFunc("/api/backup", s.handleTriggerBackup)
-	mux.HandleFunc("/api/backup/ws", s.handleBackupWS)
+	auth, err := webauth.New(cfg.Web)
+	if err != nil {
+		log.Fatalf("Invalid web auth config: %v", err)
+	}
+	if !auth.Enabled() {
+		log.Printf("Warning: web auth is not configured; every request has admin access (see web.auth in config.yml.example)")
+	}
+
+	notifier, err := notify.New(cfg.Notifications)
+	if err != nil {
+		log.Fatalf("Invalid notifications config: %v", err)
+	}
+
+	s := &webServer{
+		cfg:      cfg,
+		auth:     auth,
+		notifier: notifier,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin:     auth.CheckOrigin,
+		},
+		jobs: map[string]*backupJob{},
+	}
+
+	// SIGINT or SIGTERM cancels scheduled backups and shuts the server down.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if *schedule {
+		sched, err := newScheduler(s)
+		if err != nil {
+			log.Fatalf("Failed to configure scheduler: %v", err)
+		}
+		if err := preflightCheck(cfg); err != nil {
+			log.Fatalf("Preflight check failed: %v", err)
+		}
+		s.scheduler = sched
+		sched.Start(ctx)
+		log.Printf("Scheduler started with %d scheduled app(s)", len(sched.Entries()))
+	}
+
+	go seedBackupMetrics(context.Background(), cfg)
 
 	staticFS, err := fsSub(webUIFS, "webui")
 	if err != nil {
 		log.Fatalf("Failed to initialize web UI assets: %v", err)
 	}
-	mux.Handle("/", http.FileServer(http.FS(staticFS)))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/whoami", auth.RequireRead(s.handleWhoami))
+	mux.HandleFunc("/api/apps", auth.RequireRead(s.handleApps))
+	mux.HandleFunc("/api/backups", auth.RequireAdminToWrite(s.handleBackups))
+	mux.HandleFunc("/api/backup", auth.RequireAdminToWrite(s.handleTriggerBackup))
+	mux.HandleFunc("/api/backup/ws", auth.RequireRead(s.handleBackupWS))
+	mux.HandleFunc("/api/jobs", auth.RequireRead(s.handleJobs))
+	// Verifying changes nothing but downloads and hashes whole backups.
+	mux.HandleFunc("/api/verify", auth.RequireAdmin(s.handleVerify))
+	mux.HandleFunc("/api/restore", auth.RequireAdmin(s.handleRestore))
+	mux.HandleFunc("/metrics", auth.RequireRead(metrics.Handler().ServeHTTP))
+	mux.HandleFunc("/", auth.RequireRead(http.FileServer(http.FS(staticFS)).ServeHTTP))
+
+	srv := &http.Server{Addr: *listen, Handler: mux}
+	go func() {
+		<-ctx.Done()
+		log.Printf("Shutting down...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Printf("Web server shutdown: %v", err)
+		}
+	}()
 
 	log.Printf("Backuparr web UI listening on %s (config: %s)", *listen, path)
-	if err := http.ListenAndServe(*listen, mux); err != nil {
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		log.Fatalf("Web server failed: %v", err)
 	}
+	if s.scheduler != nil {
+		// Wait for cancelled scheduled backups to stop cleanly.
+		s.scheduler.Wait()
+	}
+	log.Printf("Stopped")
+}
+
+// seedBackupMetrics sets each backend's last-success timestamp from the
+// newest backup already stored on it, so a restarted daemon does not report
+// backends as never having succeeded.
+func seedBackupMetrics(ctx context.Context, cfg config.BackuparrConfig) {
+	for _, appCfg := range cfg.AppConfigs {
+		name := appCfg.Name
+		if name == "" {
+			name = appCfg.AppType
+		}
+		backends, err := createBackends(appCfg.Storage)
+		if err != nil {
+			log.Printf("[%s] Metrics: failed to create storage backends: %v", name, err)
+			continue
+		}
+		for _, backend := range backends {
+			backups, err := backend.List(ctx, name)
+			if err != nil {
+				log.Printf("[%s] Metrics: failed to list %s: %v", name, backend.Name(), err)
+				continue
+			}
+			if len(backups) > 0 {
+				metrics.SeedLastSuccess(name, backend.Name(), backups[0].CreatedAt)
+			}
+		}
+	}
 }
 
 func fsSub(fsys embed.FS, dir string) (fs.FS, error) {
 	return fs.Sub(fsys, dir)
 }
 
+// handleWhoami reports the caller's identity so the UI can hide actions
+// their role does not allow.
+func (s *webServer) handleWhoami(w http.ResponseWriter, r *http.Request) {
+	id, _ := webauth.FromContext(r.Context())
+	writeJSON(w, http.StatusOK, id)
+}
+
+// requester names the caller for log lines.
+func requester(r *http.Request) string {
+	if id, ok := webauth.FromContext(r.Context()); ok && id.Method != "none" {
+		return id.Name
+	}
+	return r.RemoteAddr
+}
+
 func (s *webServer) handleApps(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
 		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
@@ -176,10 +341,20 @@ func (s *webServer) handleApps(w http.ResponseWriter, r *http.Request) {
 		}
 		sort.Strings(backends)
 
+		var nextRun *time.Time
+		if s.scheduler != nil {
+			if e, ok := s.scheduler.Lookup(name); ok && !e.NextRun.IsZero() {
+				t := e.NextRun
+				nextRun = &t
+			}
+		}
+
 		apps = append(apps, appOption{
 			Name:     name,
 			AppType:  ac.AppType,
 			Backends: backends,
+			Schedule: ac.Schedule,
+			NextRun:  nextRun,
 			Retention: retentionInfo{
 				KeepLast:    ac.Retention.KeepLast,
 				KeepHourly:  ac.Retention.KeepHourly,
@@ -222,7 +397,12 @@ func (s *webServer) handleTriggerBackup(w http.ResponseWriter, r *http.Request)
 			}
 		}
 
-		job := s.startBackupJob(req)
+		job, err := s.startBackupJob(req, triggerManual)
+		if err != nil {
+			writeError(w, http.StatusConflict, err.Error())
+			return
+		}
+		log.Printf("Backup job %s requested by %s", job.ID, requester(r))
 		writeJSON(w, http.StatusAccepted, s.toJobResponse(job))
 	case http.MethodGet:
 		id := r.URL.Query().Get("id")
@@ -261,7 +441,7 @@ func (s *webServer) handleBackupWS(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	conn, err := wsUpgrader.Upgrade(w, r, nil)
+	conn, err := s.upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		return
 	}
@@ -323,10 +503,42 @@ func (s *webServer) handleBackupWS(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (s *webServer) startBackupJob(req triggerBackupRequest) *backupJob {
-	id := fmt.Sprintf("%d", time.Now().UnixNano())
+func (s *webServer) startBackupJob(req triggerBackupRequest, trigger string) (*backupJob, error) {
+	job, err := s.newBackupJob(req, trigger)
+	if err != nil {
+		return nil, err
+	}
+	go s.executeBackupJob(context.Background(), job.ID)
+	return job, nil
+}
+
+// runScheduledBackup records a job for a scheduled run and executes it
+// synchronously. A run is skipped while another backup or a restore of the
+// app is in progress. Stopping the scheduler cancels ctx and with it the
+// running backup.
+func (s *webServer) runScheduledBackup(ctx context.Context, app string) {
+	job, err := s.newBackupJob(triggerBackupRequest{App: app}, triggerSchedule)
+	if err != nil {
+		log.Printf("[%s] Skipping scheduled backup: %v", app, err)
+		return
+	}
+	s.executeBackupJob(ctx, job.ID)
+}
+
+// newJobID returns a unique job ID. A counter rather than a timestamp, so
+// schedules firing at the same instant can't overwrite each other's jobs.
+func (s *webServer) newJobID() string {
+	return strconv.FormatUint(s.lastJobID.Add(1), 10)
+}
+
+// newBackupJob records a backup job, refusing to start one while an app it
+// backs up is already being backed up or restored.
+func (s *webServer) newBackupJob(req triggerBackupRequest, trigger string) (*backupJob, error) {
+	id := s.newJobID()
 	job := &backupJob{
 		ID:        id,
+		Kind:      jobKindBackup,
+		Trigger:   trigger,
 		StartedAt: time.Now().UTC(),
 		Running:   true,
 		Request:   req,
@@ -335,26 +547,90 @@ func (s *webServer) startBackupJob(req triggerBackupRequest) *backupJob {
 	}
 
 	s.mu.Lock()
+	defer s.mu.Unlock()
+	apps := []string{req.App}
+	if req.All {
+		apps = apps[:0]
+		for _, appCfg := range s.cfg.AppConfigs {
+			name := appCfg.Name
+			if name == "" {
+				name = appCfg.AppType
+			}
+			apps = append(apps, name)
+		}
+	}
+	for _, j := range s.jobs {
+		if !j.Running {
+			continue
+		}
+		for _, app := range apps {
+			if jobTouchesApp(j, app) {
+				return nil, errJobRunning
+			}
+		}
+	}
 	s.jobs[id] = job
-	s.mu.Unlock()
+	s.pruneJobsLocked()
+
+	return s.snapshotJob(job), nil
+}
+
+// pruneJobsLocked drops the oldest finished jobs once more than
+// maxFinishedJobs have accumulated. Callers must hold s.mu.
+func (s *webServer) pruneJobsLocked() {
+	var finished []*backupJob
+	for _, j := range s.jobs {
+		if !j.Running {
+			finished = append(finished, j)
+		}
+	}
+	if len(finished) <= maxFinishedJobs {
+		return
+	}
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].StartedAt.Before(finished[j].StartedAt)
+	})
+	for _, j := range finished[:len(finished)-maxFinishedJobs] {
+		delete(s.jobs, j.ID)
+	}
+}
+
+// handleJobs lists recent backup jobs, newest first. Logs are omitted; use
+// /api/backup?id=<id> for the full job.
+func (s *webServer) handleJobs(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	s.mu.RLock()
+	jobs := make([]*backupJob, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		jobs = append(jobs, s.snapshotJob(j))
+	}
+	s.mu.RUnlock()
 
-	go s.executeBackupJob(id)
-	return s.snapshotJob(job)
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].StartedAt.After(jobs[j].StartedAt)
+	})
+
+	resp := jobsResponse{Jobs: make([]triggerBackupResponse, 0, len(jobs))}
+	for _, j := range jobs {
+		jr := s.toJobResponse(j)
+		jr.Logs = nil
+		resp.Jobs = append(resp.Jobs, jr)
+	}
+	writeJSON(w, http.StatusOK, resp)
 }
 
-func (s *webServer) executeBackupJob(id string) {
+func (s *webServer) executeBackupJob(ctx context.Context, id string) {
 	if err := preflightCheck(s.cfg); err != nil {
 		s.finishJob(id, false, []triggerBackupResult{}, []string{fmt.Sprintf("Preflight failed: %v", err)})
+		s.notifyJob(id)
 		return
 	}
 
-	logCaptureMu.Lock()
-	baseLogWriter := log.Writer()
-	log.SetOutput(io.MultiWriter(baseLogWriter, jobLogWriter{server: s, jobID: id}))
-	defer func() {
-		log.SetOutput(baseLogWriter)
-		logCaptureMu.Unlock()
-	}()
+	defer s.captureJobLogs(id)()
 
 	targetApp := ""
 	s.mu.RLock()
@@ -365,7 +641,6 @@ func (s *webServer) executeBackupJob(id string) {
 	}
 	s.mu.RUnlock()
 
-	ctx := context.Background()
 	results := make([]triggerBackupResult, 0, len(s.cfg.AppConfigs))
 	jobLogs := make([]string, 0, 32)
 
@@ -382,7 +657,7 @@ func (s *webServer) executeBackupJob(id string) {
 
 		client, err := createClient(appCfg)
 		if err != nil {
-			msg := "failed to create app client"
+			msg := "failed to create app client: " + redactSecrets(err.Error(), appCfg)
 			results = append(results, triggerBackupResult{App: name, OK: false, Status: "failed", Error: msg})
 			s.appendJobLog(id, fmt.Sprintf("[%s] %s", name, msg))
 			continue
@@ -390,13 +665,13 @@ func (s *webServer) executeBackupJob(id string) {
 
 		backends, err := createBackends(appCfg.Storage)
 		if err != nil {
-			msg := "failed to create storage backends"
+			msg := "failed to create storage backends: " + redactSecrets(err.Error(), appCfg)
 			results = append(results, triggerBackupResult{App: name, OK: false, Status: "failed", Error: msg})
 			s.appendJobLog(id, fmt.Sprintf("[%s] %s", name, msg))
 			continue
 		}
 
-		if err := runBackup(ctx, client, backends, appCfg.Retention); err != nil {
+		if err := runBackup(ctx, client, backends, appCfg); err != nil {
 			results = append(results, triggerBackupResult{App: name, OK: false, Status: "failed", Error: err.Error()})
 			s.appendJobLog(id, fmt.Sprintf("[%s] failed: %v", name, err))
 			continue
@@ -421,6 +696,52 @@ func (s *webServer) executeBackupJob(id string) {
 	}
 
 	s.finishJob(id, success, results, jobLogs)
+	s.notifyJob(id)
+}
+
+// captureJobLogs copies the standard logger's output into the job's log
+// until the returned function is called. Jobs are serialised on
+// logCaptureMu so each job only sees its own output.
+func (s *webServer) captureJobLogs(id string) func() {
+	logCaptureMu.Lock()
+	baseLogWriter := log.Writer()
+	log.SetOutput(io.MultiWriter(baseLogWriter, jobLogWriter{server: s, jobID: id}))
+	return func() {
+		log.SetOutput(baseLogWriter)
+		logCaptureMu.Unlock()
+	}
+}
+
+// notifyJob sends the finished job to the configured notification targets.
+// Restore dry runs change nothing and are not reported.
+func (s *webServer) notifyJob(id string) {
+	job, ok := s.getJob(id)
+	if !ok || (job.Restore != nil && job.Restore.DryRun) {
+		return
+	}
+
+	e := notify.Event{
+		Kind:      job.Kind,
+		JobID:     job.ID,
+		Trigger:   job.Trigger,
+		Success:   job.Success != nil && *job.Success,
+		Results:   toNotifyResults(job.Results),
+		StartedAt: job.StartedAt,
+		EndedAt:   time.Now().UTC(),
+	}
+	if job.EndedAt != nil {
+		e.EndedAt = *job.EndedAt
+	}
+	e.Host, _ = os.Hostname()
+	s.notifier.Send(context.Background(), e)
+}
+
+func toNotifyResults(results []triggerBackupResult) []notify.Result {
+	out := make([]notify.Result, 0, len(results))
+	for _, r := range results {
+		out = append(out, notify.Result{App: r.App, OK: r.OK, Status: r.Status, Error: r.Error})
+	}
+	return out
 }
 
 func (s *webServer) appendJobLog(id, line string) {
@@ -482,13 +803,23 @@ func (s *webServer) snapshotJob(job *backupJob) *backupJob {
 		success = &v
 	}
 
+	var restore *restoreRequest
+	if job.Restore != nil {
+		r := *job.Restore
+		restore = &r
+	}
+
 	return &backupJob{
 		ID:        job.ID,
+		Kind:      job.Kind,
+		Trigger:   job.Trigger,
 		StartedAt: job.StartedAt,
 		EndedAt:   endedAt,
 		Running:   job.Running,
 		Success:   success,
 		Request:   job.Request,
+		Restore:   restore,
+		Plan:      job.Plan,
 		Results:   results,
 		Logs:      logs,
 	}
@@ -504,8 +835,16 @@ func (s *webServer) toJobResponse(job *backupJob) triggerBackupResponse {
 		}
 	}
 
-	return triggerBackupResponse{
+	app := job.Request.App
+	if job.Request.All {
+		app = ""
+	}
+
+	resp := triggerBackupResponse{
 		JobID:     job.ID,
+		Kind:      job.Kind,
+		Trigger:   job.Trigger,
+		App:       app,
 		Running:   job.Running,
 		Success:   job.Success,
 		Status:    status,
@@ -514,7 +853,16 @@ func (s *webServer) toJobResponse(job *backupJob) triggerBackupResponse {
 		StartedAt: job.StartedAt,
 		EndedAt:   job.EndedAt,
 	}
+	if r := job.Restore; r != nil {
+		resp.App = r.App
+		resp.Backend = r.Backend
+		resp.Key = r.Key
+		resp.DryRun = r.DryRun
+		resp.Plan = job.Plan
+	}
+	return resp
 }
+
 func (s *webServer) handleBackups(w http.ResponseWriter, r *http.Request) {
 	appName := r.URL.Query().Get("app")
 	backendName := r.URL.Query().Get("backend")
@@ -554,6 +902,8 @@ func (s *webServer) handleBackups(w http.ResponseWriter, r *http.Request) {
 			FileName         string    `json:"fileName"`
 			Size             int64     `json:"size"`
 			CreatedAt        time.Time `json:"createdAt"`
+			Encrypted        bool      `json:"encrypted"`
+			Checksum         string    `json:"checksum,omitempty"`
 			RetentionBuckets []string  `json:"retentionBuckets"`
 		}
 
@@ -569,6 +919,8 @@ func (s *webServer) handleBackups(w http.ResponseWriter, r *http.Request) {
 				FileName:         b.FileName,
 				Size:             b.Size,
 				CreatedAt:        b.CreatedAt,
+				Encrypted:        b.Encrypted,
+				Checksum:         b.Checksum,
 				RetentionBuckets: buckets,
 			})
 		}
@@ -580,16 +932,229 @@ func (s *webServer) handleBackups(w http.ResponseWriter, r *http.Request) {
 			writeError(w, http.StatusBadRequest, "query param key is required")
 			return
 		}
-		if err := backend.Delete(ctx, key); err != nil {
+		if err := storage.DeleteBackup(ctx, backend, key); err != nil {
 			writeError(w, http.StatusInternalServerError, "failed to delete backup")
 			return
 		}
+		log.Printf("[%s] Deleted %s from %s (requested by %s)", appName, key, backendName, requester(r))
 		w.WriteHeader(http.StatusNoContent)
 	default:
 		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
 	}
 }
 
+// handleVerify downloads a backup and checks it without restoring it.
+// POST /api/verify?app=&backend=&key=
+func (s *webServer) handleVerify(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	q := r.URL.Query()
+	appName, backendName, key := q.Get("app"), q.Get("backend"), q.Get("key")
+	if appName == "" || backendName == "" || key == "" {
+		writeError(w, http.StatusBadRequest, "query params app, backend and key are required")
+		return
+	}
+
+	appCfg, err := findAppConfig(s.cfg, appName)
+	if err != nil {
+		writeError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	backend, err := findBackend(appCfg, backendName)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Only backups of this app may be verified; on the local backend a key
+	// is a filesystem path.
+	if err := requireListedBackup(r.Context(), backend, appName, key); err != nil {
+		writeError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	report, err := verifyBackup(r.Context(), appCfg, backend, key)
+	if err != nil {
+		log.Printf("[%s] Verify %s failed: %v", appName, key, err)
+		writeError(w, http.StatusInternalServerError, "failed to verify backup")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"key":    key,
+		"ok":     report.OK(),
+		"report": report,
+	})
+}
+
+// requireListedBackup returns an error unless key is one of the backups
+// backend lists for app.
+func requireListedBackup(ctx context.Context, backend storage.Backend, app, key string) error {
+	backups, err := backend.List(ctx, app)
+	if err != nil {
+		return fmt.Errorf("failed to list backups: %w", err)
+	}
+	for _, b := range backups {
+		if b.Key == key {
+			return nil
+		}
+	}
+	return fmt.Errorf("backup %s not found for %s on %s", key, app, backend.Name())
+}
+
+// handleRestore starts a restore job. The job is tracked like a backup job,
+// so its progress can be followed via /api/backup?id= and /api/backup/ws.
+// POST /api/restore {"app", "backend", "key", "confirm", "dryRun"}
+func (s *webServer) handleRestore(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req restoreRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.App == "" || req.Backend == "" || req.Key == "" {
+		writeError(w, http.StatusBadRequest, "app, backend and key are required")
+		return
+	}
+
+	appCfg, err := findAppConfig(s.cfg, req.App)
+	if err != nil {
+		writeError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	backend, err := findBackend(appCfg, req.Backend)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !req.DryRun && req.Confirm != req.App {
+		writeError(w, http.StatusBadRequest, "confirm must match the app name")
+		return
+	}
+
+	// The key must be one of this app's backups, not any path or another
+	// app's backup.
+	if err := requireListedBackup(r.Context(), backend, req.App, req.Key); err != nil {
+		writeError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	job, err := s.newRestoreJob(req)
+	if err != nil {
+		writeError(w, http.StatusConflict, err.Error())
+		return
+	}
+	log.Printf("[%s] Restore job %s of %s requested by %s", req.App, job.ID, req.Key, requester(r))
+	go s.executeRestoreJob(job.ID, req)
+
+	writeJSON(w, http.StatusAccepted, s.toJobResponse(job))
+}
+
+// newRestoreJob records a restore job, refusing to start one while a backup
+// or restore of the same app is running.
+func (s *webServer) newRestoreJob(req restoreRequest) (*backupJob, error) {
+	verb := "Restore"
+	if req.DryRun {
+		verb = "Restore dry run"
+	}
+	id := s.newJobID()
+	job := &backupJob{
+		ID:        id,
+		Kind:      jobKindRestore,
+		Trigger:   triggerManual,
+		StartedAt: time.Now().UTC(),
+		Running:   true,
+		Restore:   &req,
+		Results:   []triggerBackupResult{},
+		Logs:      []string{verb + " job started"},
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, j := range s.jobs {
+		if j.Running && jobTouchesApp(j, req.App) {
+			return nil, errJobRunning
+		}
+	}
+	s.jobs[id] = job
+	s.pruneJobsLocked()
+
+	return s.snapshotJob(job), nil
+}
+
+// jobTouchesApp reports whether job backs up or restores app.
+func jobTouchesApp(job *backupJob, app string) bool {
+	if job.Restore != nil {
+		return job.Restore.App == app
+	}
+	return job.Request.All || job.Request.App == app
+}
+
+func (s *webServer) executeRestoreJob(id string, req restoreRequest) {
+	fail := func(msg string) {
+		result := triggerBackupResult{App: req.App, OK: false, Status: "failed", Error: msg}
+		s.finishJob(id, false, []triggerBackupResult{result}, []string{fmt.Sprintf("[%s] restore failed: %s", req.App, msg)})
+		s.notifyJob(id)
+	}
+
+	if err := preflightCheck(s.cfg); err != nil {
+		fail(fmt.Sprintf("preflight failed: %v", err))
+		return
+	}
+
+	defer s.captureJobLogs(id)()
+
+	appCfg, err := findAppConfig(s.cfg, req.App)
+	if err != nil {
+		fail(err.Error())
+		return
+	}
+	client, err := createClient(appCfg)
+	if err != nil {
+		fail("failed to create app client: " + redactSecrets(err.Error(), appCfg))
+		return
+	}
+	backend, err := findBackend(appCfg, req.Backend)
+	if err != nil {
+		fail(redactSecrets(err.Error(), appCfg))
+		return
+	}
+
+	if req.DryRun {
+		s.appendJobLog(id, fmt.Sprintf("[%s] Dry run: analysing restore of %s (nothing will be changed)", req.App, req.Key))
+	} else {
+		s.appendJobLog(id, fmt.Sprintf("[%s] Restoring %s from %s", req.App, req.Key, req.Backend))
+	}
+
+	plan, err := restoreBackup(context.Background(), client, backend, req.Key, backup.RestoreOptions{DryRun: req.DryRun})
+	if err != nil {
+		fail(err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	if j, ok := s.jobs[id]; ok {
+		j.Plan = plan
+	}
+	s.mu.Unlock()
+
+	msg := "Restore job completed successfully"
+	if req.DryRun {
+		msg = "Restore dry run completed; nothing was changed"
+	}
+	s.finishJob(id, true, []triggerBackupResult{{App: req.App, OK: true, Status: "ok"}}, []string{msg})
+	s.notifyJob(id)
+}
+
 func writeJSON(w http.ResponseWriter, status int, v any) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
@@ -599,3 +1164,23 @@ func writeJSON(w http.ResponseWriter, status int, v any) {
 func writeError(w http.ResponseWriter, status int, message string) {
 	writeJSON(w, status, map[string]string{"error": message})
 }
+
+// runHashPasswordCLI reads a password from the first line of stdin and prints
+// the bcrypt hash to use as web.auth.users[].passwordHash.
+func runHashPasswordCLI() {
+	fmt.Fprintln(os.Stderr, "Enter password:")
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		log.Fatalf("Failed to read password: %v", err)
+	}
+	password := strings.TrimRight(line, "\r\n")
+	if password == "" {
+		log.Fatalf("Password must not be empty")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		log.Fatalf("Failed to hash password: %v", err)
+	}
+	fmt.Println(string(hash))
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"backuparr/internal/config"
+	"backuparr/internal/storage/local"
+)
+
+func TestHandleRestore_Validation(t *testing.T) {
+	dir := t.TempDir()
+	s := &webServer{
+		cfg: config.BackuparrConfig{
+			AppConfigs: []config.AppConfig{
+				{AppType: "sonarr", Storage: []config.StorageConfig{{Type: "local", Path: dir}}},
+				{AppType: "radarr", Storage: []config.StorageConfig{{Type: "local", Path: dir}}},
+			},
+		},
+		jobs: map[string]*backupJob{},
+	}
+	backend := local.New(dir)
+	sonarr, err := backend.Upload(context.Background(), "sonarr", "sonarr_2026-02-06T120000Z.zip", strings.NewReader("backup"), 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	radarr, err := backend.Upload(context.Background(), "radarr", "radarr_2026-02-06T120000Z.zip", strings.NewReader("backup"), 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := func(key, extra string) string {
+		b, _ := json.Marshal(key)
+		return `{"app":"sonarr","backend":"local","key":` + string(b) + extra + `}`
+	}
+	// A running backup of every app blocks restores.
+	s.jobs["1"] = &backupJob{ID: "1", Kind: jobKindBackup, Running: true, Request: triggerBackupRequest{All: true}}
+
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{"missing key", `{"app":"sonarr","backend":"local"}`, http.StatusBadRequest},
+		{"unknown app", `{"app":"lidarr","backend":"local","key":"k","confirm":"lidarr"}`, http.StatusNotFound},
+		{"unknown backend", `{"app":"sonarr","backend":"s3","key":"k","confirm":"sonarr"}`, http.StatusBadRequest},
+		{"no confirmation", `{"app":"sonarr","backend":"local","key":"k"}`, http.StatusBadRequest},
+		{"wrong confirmation", `{"app":"sonarr","backend":"local","key":"k","confirm":"radarr"}`, http.StatusBadRequest},
+		{"arbitrary file", body("/etc/passwd", `,"confirm":"sonarr"`), http.StatusNotFound},
+		{"other app's backup", body(radarr.Key, `,"confirm":"sonarr"`), http.StatusNotFound},
+		{"job running", body(sonarr.Key, `,"confirm":"sonarr"`), http.StatusConflict},
+		{"dry run needs no confirmation", body(sonarr.Key, `,"dryRun":true`), http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			s.handleRestore(rec, httptest.NewRequest(http.MethodPost, "/api/restore", strings.NewReader(tt.body)))
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d (%s)", rec.Code, tt.want, rec.Body)
+			}
+		})
+	}
+
+	if len(s.jobs) != 1 {
+		t.Errorf("%d jobs recorded, want only the running backup", len(s.jobs))
+	}
+}
+
+func TestHandleVerify_ListedKeysOnly(t *testing.T) {
+	dir := t.TempDir()
+	s := &webServer{
+		cfg: config.BackuparrConfig{
+			AppConfigs: []config.AppConfig{{
+				AppType: "sonarr",
+				Storage: []config.StorageConfig{{Type: "local", Path: dir}},
+			}},
+		},
+		jobs: map[string]*backupJob{},
+	}
+	meta, err := local.New(dir).Upload(context.Background(), "sonarr", "sonarr_2026-02-06T120000Z.zip", strings.NewReader("backup"), 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		key  string
+		want int
+	}{
+		{"listed backup", meta.Key, http.StatusOK},
+		{"arbitrary file", "/etc/passwd", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			target := "/api/verify?app=sonarr&backend=local&key=" + url.QueryEscape(tt.key)
+			s.handleVerify(rec, httptest.NewRequest(http.MethodPost, target, nil))
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d (%s)", rec.Code, tt.want, rec.Body)
+			}
+		})
+	}
+}
+
+func TestNewBackupJob_RestoreRunning(t *testing.T) {
+	s := &webServer{
+		cfg:  config.BackuparrConfig{AppConfigs: []config.AppConfig{{AppType: "sonarr"}, {AppType: "radarr"}}},
+		jobs: map[string]*backupJob{},
+	}
+	s.jobs["1"] = &backupJob{ID: "1", Kind: jobKindRestore, Running: true, Restore: &restoreRequest{App: "sonarr"}}
+
+	for _, req := range []triggerBackupRequest{{App: "sonarr"}, {All: true}} {
+		if _, err := s.newBackupJob(req, triggerSchedule); err != errJobRunning {
+			t.Errorf("newBackupJob(%+v) during restore: err = %v, want errJobRunning", req, err)
+		}
+	}
+	if _, err := s.newBackupJob(triggerBackupRequest{App: "radarr"}, triggerSchedule); err != nil {
+		t.Errorf("newBackupJob of another app: %v", err)
+	}
+
+	// Scheduled backups are skipped rather than run.
+	s.runScheduledBackup(context.Background(), "sonarr")
+	if len(s.jobs) != 2 {
+		t.Errorf("%d jobs recorded, want the restore and the radarr backup", len(s.jobs))
+	}
+}
+
+func TestNewBackupJob_BackupRunning(t *testing.T) {
+	s := &webServer{
+		cfg: config.BackuparrConfig{
+			AppConfigs: []config.AppConfig{{AppType: "sonarr"}, {AppType: "radarr", Name: "movies"}},
+		},
+		jobs: map[string]*backupJob{},
+	}
+	if _, err := s.newBackupJob(triggerBackupRequest{App: "movies"}, triggerManual); err != nil {
+		t.Fatal(err)
+	}
+
+	// A scheduled run or a backup of every app overlaps the manual backup.
+	for _, req := range []triggerBackupRequest{{App: "movies"}, {All: true}} {
+		if _, err := s.newBackupJob(req, triggerSchedule); err != errJobRunning {
+			t.Errorf("newBackupJob(%+v) during backup: err = %v, want errJobRunning", req, err)
+		}
+	}
+	if _, err := s.newBackupJob(triggerBackupRequest{App: "sonarr"}, triggerSchedule); err != nil {
+		t.Errorf("newBackupJob of another app: %v", err)
+	}
+}
+
+func TestNewBackupJob_UniqueIDs(t *testing.T) {
+	s := &webServer{jobs: map[string]*backupJob{}}
+
+	// Scheduled runs can fire at the same instant.
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s.newBackupJob(triggerBackupRequest{App: fmt.Sprintf("app%d", i)}, triggerSchedule)
+		}(i)
+	}
+	wg.Wait()
+
+	if len(s.jobs) != 50 {
+		t.Errorf("%d jobs recorded, want 50", len(s.jobs))
+	}
+}
@@ -7,17 +7,33 @@ const statusEl = document.getElementById('status');
 const logsSectionEl = document.getElementById('backupLogsSection');
 const logsEl = document.getElementById('backupLogs');
 const tbody = document.querySelector('#backupsTable tbody');
+const jobsTbody = document.querySelector('#jobsTable tbody');
+const scheduleInfoEl = document.getElementById('scheduleInfo');
+const restoreDialog = document.getElementById('restoreDialog');
+const restoreTitleEl = document.getElementById('restoreTitle');
+const restorePlanEl = document.getElementById('restorePlan');
+const restoreConfirmLabel = document.getElementById('restoreConfirmLabel');
+const restoreConfirmInput = document.getElementById('restoreConfirmInput');
+const restoreConfirmBtn = document.getElementById('restoreConfirmBtn');
+const restoreCancelBtn = document.getElementById('restoreCancelBtn');
 
 let apps = [];
 let activeSocket = null;
+let isAdmin = false;
 
 const retentionGrid = document.getElementById('retentionGrid');
 
 function updateRetentionPanel() {
   const app = apps.find(a => a.name === selectedApp());
   retentionGrid.innerHTML = '';
+  scheduleInfoEl.textContent = '';
   if (!app) return;
 
+  if (app.schedule) {
+    const next = app.nextRun ? new Date(app.nextRun).toLocaleString() : 'not scheduled (daemon not running)';
+    scheduleInfoEl.textContent = `Schedule: ${app.schedule} — next run: ${next}`;
+  }
+
   const r = app.retention || {};
   const items = [
     { label: 'Last',    value: r.keepLast,    cls: 'latest' },
@@ -51,6 +67,18 @@ function setBusy(isBusy) {
   refreshBtn.disabled = isBusy;
   backupSelectedBtn.disabled = isBusy;
   backupAllBtn.disabled = isBusy;
+  tbody.querySelectorAll('button').forEach(btn => { btn.disabled = isBusy; });
+}
+
+// loadIdentity hides actions that need the admin role from read-only users.
+// The server enforces roles regardless.
+async function loadIdentity() {
+  const res = await fetch('/api/whoami');
+  if (!res.ok) throw new Error('failed to load identity');
+  const id = await res.json();
+  isAdmin = id.role === 'admin';
+  backupSelectedBtn.classList.toggle('hidden', !isAdmin);
+  backupAllBtn.classList.toggle('hidden', !isAdmin);
 }
 
 function showLogsSection(show) {
@@ -108,6 +136,8 @@ async function loadApps() {
   const data = await res.json();
   apps = data.apps || [];
 
+  const previousApp = appSelect.value;
+  const previousBackend = backendSelect.value;
   appSelect.innerHTML = '';
   apps.forEach(app => {
     const opt = document.createElement('option');
@@ -115,8 +145,14 @@ async function loadApps() {
     opt.textContent = `${app.name} (${app.appType})`;
     appSelect.appendChild(opt);
   });
+  if (apps.some(a => a.name === previousApp)) {
+    appSelect.value = previousApp;
+  }
 
   updateBackends();
+  if ([...backendSelect.options].some(o => o.value === previousBackend)) {
+    backendSelect.value = previousBackend;
+  }
 }
 
 async function deleteBackup(key) {
@@ -137,6 +173,124 @@ async function deleteBackup(key) {
   await loadBackups();
 }
 
+async function verifyBackup(key) {
+  setStatus(`Verifying ${key}...`);
+
+  const params = new URLSearchParams({
+    app: selectedApp(),
+    backend: selectedBackend(),
+    key,
+  });
+
+  const res = await fetch(`/api/verify?${params.toString()}`, { method: 'POST' });
+  const body = await res.json().catch(() => ({}));
+  if (!res.ok) {
+    throw new Error(body.error || 'verify failed');
+  }
+
+  const checks = (body.report && body.report.checks) || [];
+  const lines = checks.map(c => `${c.status.toUpperCase()}  ${c.name}${c.detail ? ` - ${c.detail}` : ''}`);
+  setStatus(body.ok ? `Verified OK: ${key}` : `Verification FAILED: ${key}`);
+  alert(`${body.ok ? 'Backup OK' : 'Backup FAILED verification'}\n\n${lines.join('\n')}`);
+}
+
+function formatPlan(plan) {
+  const lines = [`App:      ${plan.app}`];
+  if (plan.dbType) lines.push(`Database: ${plan.dbType}`);
+  if (plan.postgres) {
+    const pg = plan.postgres;
+    lines.push(`Postgres: ${pg.user}@${pg.host}:${pg.port}`);
+    (pg.databases || []).forEach(db => lines.push(`  DROP all tables in ${db}.public, then load dump`));
+  }
+  if (plan.files && plan.files.length > 0) {
+    lines.push(`Files:    ${plan.files.length}`);
+    plan.files.forEach(f => lines.push(`  ${f.action.padEnd(9)} ${f.path} (${formatBytes(f.size)})`));
+  }
+  let restart = plan.restart ? 'yes' : 'no';
+  if (plan.restart && plan.restartMethod) restart += ` (${plan.restartMethod})`;
+  lines.push(`Restart:  ${restart}`);
+  (plan.notes || []).forEach(n => lines.push(`Note:     ${n}`));
+  return lines.join('\n');
+}
+
+// confirmRestore shows the dry-run plan and resolves true once the user has
+// typed the app name and clicked Restore, or false if they cancel.
+function confirmRestore(app, key, plan) {
+  restoreTitleEl.textContent = `Restore ${app}?`;
+  restorePlanEl.textContent = `${key}\n\n${formatPlan(plan)}`;
+  restoreConfirmLabel.textContent = `Type "${app}" to confirm. This overwrites the running app's data.`;
+  restoreConfirmInput.value = '';
+  restoreConfirmBtn.disabled = true;
+
+  return new Promise(resolve => {
+    restoreConfirmInput.oninput = () => {
+      restoreConfirmBtn.disabled = restoreConfirmInput.value !== app;
+    };
+    restoreConfirmBtn.onclick = () => {
+      restoreDialog.close();
+      resolve(true);
+    };
+    restoreCancelBtn.onclick = () => restoreDialog.close();
+    restoreDialog.onclose = () => resolve(false);
+    restoreDialog.showModal();
+    restoreConfirmInput.focus();
+  });
+}
+
+async function startRestoreJob(payload) {
+  const res = await fetch('/api/restore', {
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json' },
+    body: JSON.stringify(payload),
+  });
+  const body = await res.json().catch(() => ({}));
+  if (!res.ok) {
+    throw new Error(body.error || 'restore failed');
+  }
+  if (!body.jobId) {
+    throw new Error('restore job id missing');
+  }
+  return streamJob(body.jobId);
+}
+
+function jobError(job) {
+  const failed = (job.results || []).find(r => !r.ok);
+  return (failed && failed.error) || job.status;
+}
+
+async function restoreBackup(key) {
+  const app = selectedApp();
+  const backend = selectedBackend();
+
+  setBusy(true);
+  try {
+    showLogsSection(true);
+    setLogs([]);
+    setStatus(`Checking what restoring ${key} would change...`);
+
+    const preview = await startRestoreJob({ app, backend, key, dryRun: true });
+    if (!preview.success) {
+      throw new Error(`dry run failed: ${jobError(preview)}`);
+    }
+
+    if (!(await confirmRestore(app, key, preview.plan || { app }))) {
+      setStatus('Restore cancelled');
+      return;
+    }
+
+    setLogs([]);
+    setStatus(`Restoring ${app}...`);
+    const job = await startRestoreJob({ app, backend, key, confirm: app });
+    if (!job.success) {
+      throw new Error(`restore failed: ${jobError(job)}`);
+    }
+    setStatus(`Restore of ${app} from ${key} complete`);
+  } finally {
+    setBusy(false);
+    await loadJobs().catch(() => {});
+  }
+}
+
 async function loadBackups() {
   const app = selectedApp();
   const backend = selectedBackend();
@@ -162,6 +316,13 @@ async function loadBackups() {
 
     const fileTd = document.createElement('td');
     fileTd.textContent = b.FileName || b.fileName || '-';
+    if (b.encrypted) {
+      const badge = document.createElement('span');
+      badge.className = 'badge badge-encrypted';
+      badge.textContent = 'encrypted';
+      badge.style.marginLeft = '6px';
+      fileTd.appendChild(badge);
+    }
 
     const sizeTd = document.createElement('td');
     sizeTd.textContent = formatBytes(b.Size ?? b.size);
@@ -203,7 +364,34 @@ async function loadBackups() {
         setStatus(`Error: ${err.message}`);
       }
     };
-    actionTd.appendChild(delBtn);
+    const verifyBtn = document.createElement('button');
+    verifyBtn.textContent = 'Verify';
+    verifyBtn.disabled = !key;
+    verifyBtn.onclick = async () => {
+      verifyBtn.disabled = true;
+      try {
+        await verifyBackup(key);
+      } catch (err) {
+        setStatus(`Error: ${err.message}`);
+      } finally {
+        verifyBtn.disabled = false;
+      }
+    };
+    const restoreBtn = document.createElement('button');
+    restoreBtn.textContent = 'Restore';
+    restoreBtn.disabled = !key;
+    restoreBtn.onclick = async () => {
+      try {
+        await restoreBackup(key);
+      } catch (err) {
+        setStatus(`Error: ${err.message}`);
+      }
+    };
+    if (isAdmin) {
+      actionTd.appendChild(verifyBtn);
+      actionTd.appendChild(restoreBtn);
+      actionTd.appendChild(delBtn);
+    }
 
     tr.appendChild(fileTd);
     tr.appendChild(sizeTd);
@@ -217,6 +405,38 @@ async function loadBackups() {
   setStatus(`${backups.length} backup(s)`);
 }
 
+function formatDuration(job) {
+  if (!job.endedAt) return '-';
+  const secs = Math.round((new Date(job.endedAt) - new Date(job.startedAt)) / 1000);
+  if (secs < 60) return `${secs}s`;
+  return `${Math.floor(secs / 60)}m ${secs % 60}s`;
+}
+
+async function loadJobs() {
+  const res = await fetch('/api/jobs');
+  if (!res.ok) throw new Error('failed to load jobs');
+  const data = await res.json();
+
+  jobsTbody.innerHTML = '';
+  (data.jobs || []).slice(0, 20).forEach(job => {
+    const tr = document.createElement('tr');
+    const cells = [
+      new Date(job.startedAt).toLocaleString(),
+      job.dryRun ? `${job.kind} (dry run)` : (job.kind || 'backup'),
+      job.trigger || 'manual',
+      job.app || 'all',
+      job.status,
+      formatDuration(job),
+    ];
+    cells.forEach(text => {
+      const td = document.createElement('td');
+      td.textContent = text;
+      tr.appendChild(td);
+    });
+    jobsTbody.appendChild(tr);
+  });
+}
+
 async function triggerBackup(payload) {
   setBusy(true);
   try {
@@ -244,6 +464,7 @@ async function triggerBackup(payload) {
     setStatus(`Backup complete: ${summary.ok} succeeded, ${summary.failed} failed`);
 
     await loadBackups();
+    await loadJobs();
   } finally {
     setBusy(false);
   }
@@ -256,13 +477,14 @@ function renderJobUpdate(job) {
 
   setLogs(job.logs || []);
 
+  const kind = job.kind === 'restore' ? 'Restore' : 'Backup';
   if (job.running) {
-    setStatus(`Backup running (${job.status})...`);
+    setStatus(`${kind} running (${job.status})...`);
     return;
   }
 
   const summary = summarizeResults(job);
-  setStatus(`Backup ${job.status}: ${summary.ok} succeeded, ${summary.failed} failed`);
+  setStatus(`${kind} ${job.status}: ${summary.ok} succeeded, ${summary.failed} failed`);
 }
 
 async function pollJobUntilDone(jobId) {
@@ -270,7 +492,7 @@ async function pollJobUntilDone(jobId) {
     const params = new URLSearchParams({ id: jobId });
     const res = await fetch(`/api/backup?${params.toString()}`);
     const body = await res.json().catch(() => ({}));
-    if (!res.ok) throw new Error(body.error || 'failed to poll backup job');
+    if (!res.ok) throw new Error(body.error || 'failed to poll job');
 
     renderJobUpdate(body);
     if (!body.running) return body;
@@ -341,8 +563,10 @@ function streamJob(jobId) {
 async function init() {
   try {
     showLogsSection(false);
+    await loadIdentity();
     await loadApps();
     await loadBackups();
+    await loadJobs();
     setLogs([]);
   } catch (err) {
     setStatus(`Error: ${err.message}`);
@@ -355,7 +579,15 @@ appSelect.addEventListener('change', async () => {
 });
 
 backendSelect.addEventListener('change', loadBackups);
-refreshBtn.addEventListener('click', loadBackups);
+refreshBtn.addEventListener('click', async () => {
+  try {
+    await loadApps();
+    await loadBackups();
+    await loadJobs();
+  } catch (err) {
+    setStatus(`Error: ${err.message}`);
+  }
+});
 backupSelectedBtn.addEventListener('click', async () => {
   try {
     await triggerBackup({ app: selectedApp() });
@@ -3,9 +3,10 @@ package main
 import (
 	"encoding/json"
 	"fmt"
-	"io"
 	"log"
 	"net/http"
+
+	"backuparr/internal/dirbackup"
 )
 
 // handleHealth reports sidecar status and capabilities.
@@ -20,6 +21,9 @@ func handleHealth(cfg *config) http.HandlerFunc {
 		resp := map[string]any{
 			"status":     "ok",
 			"backupPath": cfg.BackupPath,
+			// Older sidecars ignore ?dryRun=true and restore for real, so
+			// clients check for this before requesting a dry run.
+			"dryRun": true,
 			"restart": map[string]any{
 				"docker":     cfg.DockerContainer != "",
 				"kubernetes": cfg.KubePod != "",
@@ -44,7 +48,7 @@ func handleBackup(cfg *config) http.HandlerFunc {
 		w.Header().Set("Content-Type", "application/zip")
 		w.Header().Set("Content-Disposition", `attachment; filename="backup.zip"`)
 
-		stats, err := createBackup(cfg.BackupPath, cfg.ExcludePatterns, w)
+		stats, err := cfg.dir().Backup(w)
 		if err != nil {
 			// If headers haven't been sent yet, return a proper error
 			log.Printf("[sidecar] Backup failed: %v", err)
@@ -61,6 +65,7 @@ func handleBackup(cfg *config) http.HandlerFunc {
 // handleRestore accepts a ZIP upload and extracts it to the backup path.
 // Optionally restarts the target container/pod after a successful restore.
 // POST /api/v1/restore  (multipart/form-data with field "backup")
+// POST /api/v1/restore?dryRun=true  lists the files that would be written
 func handleRestore(cfg *config) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
@@ -73,20 +78,34 @@ func handleRestore(cfg *config) http.HandlerFunc {
 		// Limit upload to 2 GB
 		r.Body = http.MaxBytesReader(w, r.Body, 2<<30)
 
-		file, _, err := r.FormFile("backup")
+		// Uploads larger than the multipart memory limit are spooled to a
+		// temp file by net/http, so the archive is read from disk rather
+		// than held in memory.
+		file, header, err := r.FormFile("backup")
 		if err != nil {
 			httpError(w, http.StatusBadRequest, fmt.Sprintf("missing or invalid 'backup' form file: %v", err))
 			return
 		}
 		defer file.Close()
 
-		data, err := io.ReadAll(file)
-		if err != nil {
-			httpError(w, http.StatusBadRequest, fmt.Sprintf("failed to read upload: %v", err))
+		// ?dryRun=true reports what would be written without restoring.
+		if r.URL.Query().Get("dryRun") == "true" {
+			files, err := cfg.dir().PlanRestore(file, header.Size)
+			if err != nil {
+				httpError(w, http.StatusBadRequest, fmt.Sprintf("dry run failed: %v", err))
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]any{
+				"success": true,
+				"dryRun":  true,
+				"files":   files,
+				"restart": restartResult{Method: restartMethod(cfg)},
+				"message": fmt.Sprintf("Would restore %d files into %s", len(files), cfg.BackupPath),
+			})
 			return
 		}
 
-		stats, err := restoreFromZip(cfg.BackupPath, data)
+		stats, err := cfg.dir().Restore(file, header.Size)
 		if err != nil {
 			httpError(w, http.StatusInternalServerError, fmt.Sprintf("restore failed: %v", err))
 			return
@@ -157,7 +176,7 @@ func authMiddleware(apiKey string, next http.HandlerFunc) http.HandlerFunc {
 }
 
 // restoreMessage generates a human-readable summary of a restore operation.
-func restoreMessage(stats *restoreStats, restart restartResult) string {
+func restoreMessage(stats *dirbackup.RestoreStats, restart restartResult) string {
 	msg := fmt.Sprintf("Restored %d files (%d bytes)", stats.FilesRestored, stats.BytesRestored)
 	if restart.Attempted {
 		if restart.Success {
@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"os"
 	"strings"
+
+	"backuparr/internal/dirbackup"
 )
 
 // config holds the sidecar runtime configuration, loaded from environment variables.
@@ -25,6 +27,11 @@ type config struct {
 	KubeNamespace string // KUBE_NAMESPACE — K8s namespace (auto-detected from SA if empty)
 }
 
+// dir returns the directory tree served by the sidecar.
+func (c *config) dir() *dirbackup.Dir {
+	return &dirbackup.Dir{Path: c.BackupPath, Excludes: c.ExcludePatterns, LogPrefix: "sidecar"}
+}
+
 // loadConfig reads configuration from environment variables.
 func loadConfig() (*config, error) {
 	cfg := &config{
@@ -136,6 +136,18 @@ type restartResult struct {
 	Error     string `json:"error,omitempty"`
 }
 
+// restartMethod returns the restart method tryRestart would use, or "" if
+// none is configured.
+func restartMethod(cfg *config) string {
+	switch {
+	case cfg.DockerContainer != "":
+		return "docker"
+	case cfg.KubePod != "":
+		return "kubernetes"
+	}
+	return ""
+}
+
 // tryRestart attempts to restart the target app using whichever method is configured.
 // Returns a restartResult describing what happened. If no restart method is configured,
 // Attempted will be false.
@@ -78,6 +78,17 @@ var testInstances = []testInstance{
 		pgOverride:    nil,
 		isPostgres:    false,
 	},
+	{
+		name:          "prowlarr-postgres",
+		containerName: "prowlarr-postgres",
+		appType:       "prowlarr",
+		url:           "http://localhost:9697",
+		pgOverride: &backup.PostgresConfig{
+			Host: "localhost",
+			Port: "5435",
+		},
+		isPostgres: true,
+	},
 }
 
 // configXML is used to parse the API key from container config
@@ -119,7 +130,7 @@ func createClient(t *testing.T, inst testInstance) backup.Client {
 	case "radarr":
 		client, err = radarr.NewRadarrClient(inst.url, apiKey, "", "", inst.pgOverride)
 	case "prowlarr":
-		client, err = prowlarr.NewProwlarrClient(inst.url, apiKey, "", "")
+		client, err = prowlarr.NewProwlarrClient(inst.url, apiKey, "", "", inst.pgOverride)
 	default:
 		t.Fatalf("unknown app type: %s", inst.appType)
 	}
@@ -424,7 +435,7 @@ func TestRestore(t *testing.T) {
 
 			// Now restore from the backup
 			t.Logf("Restoring from backup...")
-			err = client.Restore(ctx, bytes.NewReader(backupData))
+			_, err = client.Restore(ctx, bytes.NewReader(backupData), backup.RestoreOptions{})
 			if err != nil {
 				t.Fatalf("Restore failed: %v", err)
 			}
@@ -516,7 +527,7 @@ func TestRestorePostgres(t *testing.T) {
 
 			// Now restore from the backup
 			t.Logf("Restoring from backup (including PostgreSQL databases)...")
-			err = client.Restore(ctx, bytes.NewReader(backupData))
+			_, err = client.Restore(ctx, bytes.NewReader(backupData), backup.RestoreOptions{})
 			if err != nil {
 				t.Fatalf("Restore failed: %v", err)
 			}
@@ -697,7 +708,7 @@ func TestBadCredentials(t *testing.T) {
 
 	// Step 3: Try backup with WRONG credentials — should fail with auth error
 	t.Log("Step 3: Attempting backup with wrong credentials...")
-	badClient, err := prowlarr.NewProwlarrClient(inst.url, apiKey, "wronguser", "wrongpassword")
+	badClient, err := prowlarr.NewProwlarrClient(inst.url, apiKey, "wronguser", "wrongpassword", nil)
 	if err != nil {
 		t.Fatalf("failed to create client: %v", err)
 	}
@@ -722,7 +733,7 @@ func TestBadCredentials(t *testing.T) {
 
 	// Step 4: Verify correct credentials still work
 	t.Log("Step 4: Verifying correct credentials work...")
-	goodClient, err := prowlarr.NewProwlarrClient(inst.url, apiKey, "testadmin", "testpassword123")
+	goodClient, err := prowlarr.NewProwlarrClient(inst.url, apiKey, "testadmin", "testpassword123", nil)
 	if err != nil {
 		t.Fatalf("failed to create client: %v", err)
 	}
@@ -0,0 +1,84 @@
+package integration_tests
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"backuparr/internal/backup"
+	"backuparr/internal/postgres"
+)
+
+// psqlInContainer runs sql against db on the Sonarr PostgreSQL container and
+// returns the unaligned output.
+func psqlInContainer(t *testing.T, db, sql string) string {
+	t.Helper()
+	cmd := exec.Command("docker", "exec", "sonarr-postgres-db", "psql", "-U", "sonarr", "-d", db, "-v", "ON_ERROR_STOP=1", "-tAc", sql)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("psql %q failed: %v - %s", sql, err, output)
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// TestRestoreGenericPostgres backs up a database holding objects outside the
+// public tables (a schema, an enum type and a function) with the generic
+// postgres client and restores it over the live database.
+func TestRestoreGenericPostgres(t *testing.T) {
+	if os.Getenv("INTEGRATION_TEST") == "" {
+		t.Skip("Skipping integration test. Set INTEGRATION_TEST=1 to run.")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	const db = "generic-restore-test"
+	psqlInContainer(t, "postgres", `DROP DATABASE IF EXISTS "`+db+`"`)
+	psqlInContainer(t, "postgres", `CREATE DATABASE "`+db+`"`)
+	t.Cleanup(func() { psqlInContainer(t, "postgres", `DROP DATABASE IF EXISTS "`+db+`"`) })
+
+	psqlInContainer(t, db, `
+		CREATE SCHEMA app;
+		CREATE TYPE app.mood AS ENUM ('happy', 'sad');
+		CREATE FUNCTION app.cheer(m app.mood) RETURNS app.mood LANGUAGE sql AS $$ SELECT 'happy'::app.mood $$;
+		CREATE TABLE app.people (name text PRIMARY KEY, mood app.mood NOT NULL);
+		INSERT INTO app.people VALUES ('alice', 'sad');`)
+
+	client, err := postgres.NewClient("generic", &backup.PostgresConfig{
+		Host:     "localhost",
+		Port:     "5433",
+		User:     "sonarr",
+		Password: "sonarr_test_password",
+	}, []string{db})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, reader, err := client.Backup(ctx)
+	if err != nil {
+		t.Fatalf("Backup failed: %v", err)
+	}
+	backupData, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		t.Fatalf("Failed to read backup data: %v", err)
+	}
+
+	psqlInContainer(t, db, `INSERT INTO app.people VALUES ('bob', 'happy')`)
+
+	if _, err := client.Restore(ctx, bytes.NewReader(backupData), backup.RestoreOptions{}); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+
+	if got := psqlInContainer(t, db, `SELECT string_agg(name || ':' || mood, ',') FROM app.people`); got != "alice:sad" {
+		t.Errorf("people after restore = %q, want alice:sad", got)
+	}
+	if got := psqlInContainer(t, db, `SELECT app.cheer('sad')`); got != "happy" {
+		t.Errorf("app.cheer after restore = %q", got)
+	}
+}
@@ -11,11 +11,29 @@ import (
 	"testing"
 	"time"
 
-	"backuparr/internal/prowlarr"
-	"backuparr/internal/radarr"
-	"backuparr/internal/sonarr"
+	"backuparr/internal/backup"
 )
 
+// The resources below carry only the fields these tests inspect. Lookup
+// results are posted back as decoded maps so the *arr API sees every field.
+
+type seriesResource struct {
+	Id     *int32  `json:"id,omitempty"`
+	Title  *string `json:"title,omitempty"`
+	TvdbId *int32  `json:"tvdbId,omitempty"`
+}
+
+type movieResource struct {
+	Id     *int32  `json:"id,omitempty"`
+	Title  *string `json:"title,omitempty"`
+	TmdbId *int32  `json:"tmdbId,omitempty"`
+}
+
+type tagResource struct {
+	Id    *int32  `json:"id,omitempty"`
+	Label *string `json:"label,omitempty"`
+}
+
 // TestRestoreValidationSonarr tests that restoring a backup properly restores the original state
 // by adding a series, backing up, adding more series, then restoring and verifying
 func TestRestoreValidationSonarr(t *testing.T) {
@@ -43,7 +61,7 @@ func TestRestoreValidationSonarr(t *testing.T) {
 	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	// Helper to get all series
-	getSeries := func() ([]sonarr.SeriesResource, error) {
+	getSeries := func() ([]seriesResource, error) {
 		req, _ := http.NewRequestWithContext(ctx, "GET", inst.url+"/api/v3/series", nil)
 		req.Header.Set("X-Api-Key", apiKey)
 		resp, err := httpClient.Do(req)
@@ -51,7 +69,7 @@ func TestRestoreValidationSonarr(t *testing.T) {
 			return nil, err
 		}
 		defer resp.Body.Close()
-		var series []sonarr.SeriesResource
+		var series []seriesResource
 		if err := json.NewDecoder(resp.Body).Decode(&series); err != nil {
 			return nil, err
 		}
@@ -59,7 +77,7 @@ func TestRestoreValidationSonarr(t *testing.T) {
 	}
 
 	// Helper to add a series by TVDB ID
-	addSeries := func(tvdbID int32) (*sonarr.SeriesResource, error) {
+	addSeries := func(tvdbID int32) (*seriesResource, error) {
 		// First lookup the series
 		req, _ := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v3/series/lookup?term=tvdb:%d", inst.url, tvdbID), nil)
 		req.Header.Set("X-Api-Key", apiKey)
@@ -69,7 +87,7 @@ func TestRestoreValidationSonarr(t *testing.T) {
 		}
 		defer resp.Body.Close()
 
-		var lookupResults []sonarr.SeriesResource
+		var lookupResults []map[string]any
 		if err := json.NewDecoder(resp.Body).Decode(&lookupResults); err != nil {
 			return nil, fmt.Errorf("lookup decode failed: %w", err)
 		}
@@ -79,18 +97,11 @@ func TestRestoreValidationSonarr(t *testing.T) {
 		}
 
 		series := lookupResults[0]
-		monitored := true
-		series.Monitored = &monitored
-		qualityProfileID := int32(1)
-		series.QualityProfileId = &qualityProfileID
-		rootFolder := "/tv"
-		series.RootFolderPath = &rootFolder
-		seasonFolder := true
-		series.SeasonFolder = &seasonFolder
-		searchMissing := false
-		series.AddOptions = &sonarr.AddSeriesOptions{
-			SearchForMissingEpisodes: &searchMissing,
-		}
+		series["monitored"] = true
+		series["qualityProfileId"] = 1
+		series["rootFolderPath"] = "/tv"
+		series["seasonFolder"] = true
+		series["addOptions"] = map[string]any{"searchForMissingEpisodes": false}
 
 		body, _ := json.Marshal(series)
 		req, _ = http.NewRequestWithContext(ctx, "POST", inst.url+"/api/v3/series", bytes.NewReader(body))
@@ -108,7 +119,7 @@ func TestRestoreValidationSonarr(t *testing.T) {
 			return nil, fmt.Errorf("add failed with status %d: %s", resp.StatusCode, string(respBody))
 		}
 
-		var added sonarr.SeriesResource
+		var added seriesResource
 		if err := json.NewDecoder(resp.Body).Decode(&added); err != nil {
 			return nil, fmt.Errorf("add decode failed: %w", err)
 		}
@@ -214,7 +225,7 @@ func TestRestoreValidationSonarr(t *testing.T) {
 
 	// Step 6: Restore from backup
 	t.Log("Step 6: Restoring from backup...")
-	err = client.Restore(ctx, bytes.NewReader(backupData))
+	_, err = client.Restore(ctx, bytes.NewReader(backupData), backup.RestoreOptions{})
 	if err != nil {
 		t.Fatalf("failed to restore: %v", err)
 	}
@@ -281,7 +292,7 @@ func TestRestoreValidationRadarr(t *testing.T) {
 	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	// Helper to get all movies
-	getMovies := func() ([]radarr.MovieResource, error) {
+	getMovies := func() ([]movieResource, error) {
 		req, _ := http.NewRequestWithContext(ctx, "GET", inst.url+"/api/v3/movie", nil)
 		req.Header.Set("X-Api-Key", apiKey)
 		resp, err := httpClient.Do(req)
@@ -289,7 +300,7 @@ func TestRestoreValidationRadarr(t *testing.T) {
 			return nil, err
 		}
 		defer resp.Body.Close()
-		var movies []radarr.MovieResource
+		var movies []movieResource
 		if err := json.NewDecoder(resp.Body).Decode(&movies); err != nil {
 			return nil, err
 		}
@@ -297,7 +308,7 @@ func TestRestoreValidationRadarr(t *testing.T) {
 	}
 
 	// Helper to add a movie by TMDB ID
-	addMovie := func(tmdbID int32) (*radarr.MovieResource, error) {
+	addMovie := func(tmdbID int32) (*movieResource, error) {
 		// First lookup the movie
 		req, _ := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v3/movie/lookup?term=tmdb:%d", inst.url, tmdbID), nil)
 		req.Header.Set("X-Api-Key", apiKey)
@@ -307,7 +318,7 @@ func TestRestoreValidationRadarr(t *testing.T) {
 		}
 		defer resp.Body.Close()
 
-		var lookupResults []radarr.MovieResource
+		var lookupResults []map[string]any
 		if err := json.NewDecoder(resp.Body).Decode(&lookupResults); err != nil {
 			return nil, fmt.Errorf("lookup decode failed: %w", err)
 		}
@@ -317,18 +328,11 @@ func TestRestoreValidationRadarr(t *testing.T) {
 		}
 
 		movie := lookupResults[0]
-		monitored := true
-		movie.Monitored = &monitored
-		qualityProfileID := int32(1)
-		movie.QualityProfileId = &qualityProfileID
-		rootFolder := "/movies"
-		movie.RootFolderPath = &rootFolder
-		minAvail := radarr.Announced
-		movie.MinimumAvailability = &minAvail
-		searchForMovie := false
-		movie.AddOptions = &radarr.AddMovieOptions{
-			SearchForMovie: &searchForMovie,
-		}
+		movie["monitored"] = true
+		movie["qualityProfileId"] = 1
+		movie["rootFolderPath"] = "/movies"
+		movie["minimumAvailability"] = "announced"
+		movie["addOptions"] = map[string]any{"searchForMovie": false}
 
 		body, _ := json.Marshal(movie)
 		req, _ = http.NewRequestWithContext(ctx, "POST", inst.url+"/api/v3/movie", bytes.NewReader(body))
@@ -346,7 +350,7 @@ func TestRestoreValidationRadarr(t *testing.T) {
 			return nil, fmt.Errorf("add failed with status %d: %s", resp.StatusCode, string(respBody))
 		}
 
-		var added radarr.MovieResource
+		var added movieResource
 		if err := json.NewDecoder(resp.Body).Decode(&added); err != nil {
 			return nil, fmt.Errorf("add decode failed: %w", err)
 		}
@@ -454,7 +458,7 @@ func TestRestoreValidationRadarr(t *testing.T) {
 
 	// Step 6: Restore from backup
 	t.Log("Step 6: Restoring from backup...")
-	err = client.Restore(ctx, bytes.NewReader(backupData))
+	_, err = client.Restore(ctx, bytes.NewReader(backupData), backup.RestoreOptions{})
 	if err != nil {
 		t.Fatalf("failed to restore: %v", err)
 	}
@@ -538,7 +542,7 @@ func runSonarrValidation(t *testing.T, ctx context.Context, inst testInstance) {
 
 	httpClient := &http.Client{Timeout: 30 * time.Second}
 
-	getSeries := func() ([]sonarr.SeriesResource, error) {
+	getSeries := func() ([]seriesResource, error) {
 		req, _ := http.NewRequestWithContext(ctx, "GET", inst.url+"/api/v3/series", nil)
 		req.Header.Set("X-Api-Key", apiKey)
 		resp, err := httpClient.Do(req)
@@ -546,12 +550,12 @@ func runSonarrValidation(t *testing.T, ctx context.Context, inst testInstance) {
 			return nil, err
 		}
 		defer resp.Body.Close()
-		var series []sonarr.SeriesResource
+		var series []seriesResource
 		json.NewDecoder(resp.Body).Decode(&series)
 		return series, nil
 	}
 
-	addSeries := func(tvdbID int32) (*sonarr.SeriesResource, error) {
+	addSeries := func(tvdbID int32) (*seriesResource, error) {
 		req, _ := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v3/series/lookup?term=tvdb:%d", inst.url, tvdbID), nil)
 		req.Header.Set("X-Api-Key", apiKey)
 		resp, err := httpClient.Do(req)
@@ -560,23 +564,18 @@ func runSonarrValidation(t *testing.T, ctx context.Context, inst testInstance) {
 		}
 		defer resp.Body.Close()
 
-		var results []sonarr.SeriesResource
+		var results []map[string]any
 		json.NewDecoder(resp.Body).Decode(&results)
 		if len(results) == 0 {
 			return nil, fmt.Errorf("no results")
 		}
 
 		series := results[0]
-		monitored := true
-		series.Monitored = &monitored
-		qp := int32(1)
-		series.QualityProfileId = &qp
-		rf := "/tv"
-		series.RootFolderPath = &rf
-		sf := true
-		series.SeasonFolder = &sf
-		search := false
-		series.AddOptions = &sonarr.AddSeriesOptions{SearchForMissingEpisodes: &search}
+		series["monitored"] = true
+		series["qualityProfileId"] = 1
+		series["rootFolderPath"] = "/tv"
+		series["seasonFolder"] = true
+		series["addOptions"] = map[string]any{"searchForMissingEpisodes": false}
 
 		body, _ := json.Marshal(series)
 		req, _ = http.NewRequestWithContext(ctx, "POST", inst.url+"/api/v3/series", bytes.NewReader(body))
@@ -591,7 +590,7 @@ func runSonarrValidation(t *testing.T, ctx context.Context, inst testInstance) {
 			b, _ := io.ReadAll(resp.Body)
 			return nil, fmt.Errorf("status %d: %s", resp.StatusCode, b)
 		}
-		var added sonarr.SeriesResource
+		var added seriesResource
 		json.NewDecoder(resp.Body).Decode(&added)
 		return &added, nil
 	}
@@ -657,7 +656,7 @@ func runSonarrValidation(t *testing.T, ctx context.Context, inst testInstance) {
 
 	// Restore
 	t.Log("Restoring...")
-	if err := client.Restore(ctx, bytes.NewReader(backupData)); err != nil {
+	if _, err := client.Restore(ctx, bytes.NewReader(backupData), backup.RestoreOptions{}); err != nil {
 		t.Fatalf("restore failed: %v", err)
 	}
 
@@ -687,7 +686,7 @@ func runRadarrValidation(t *testing.T, ctx context.Context, inst testInstance) {
 
 	httpClient := &http.Client{Timeout: 30 * time.Second}
 
-	getMovies := func() ([]radarr.MovieResource, error) {
+	getMovies := func() ([]movieResource, error) {
 		req, _ := http.NewRequestWithContext(ctx, "GET", inst.url+"/api/v3/movie", nil)
 		req.Header.Set("X-Api-Key", apiKey)
 		resp, err := httpClient.Do(req)
@@ -695,12 +694,12 @@ func runRadarrValidation(t *testing.T, ctx context.Context, inst testInstance) {
 			return nil, err
 		}
 		defer resp.Body.Close()
-		var movies []radarr.MovieResource
+		var movies []movieResource
 		json.NewDecoder(resp.Body).Decode(&movies)
 		return movies, nil
 	}
 
-	addMovie := func(tmdbID int32) (*radarr.MovieResource, error) {
+	addMovie := func(tmdbID int32) (*movieResource, error) {
 		req, _ := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v3/movie/lookup?term=tmdb:%d", inst.url, tmdbID), nil)
 		req.Header.Set("X-Api-Key", apiKey)
 		resp, err := httpClient.Do(req)
@@ -709,23 +708,18 @@ func runRadarrValidation(t *testing.T, ctx context.Context, inst testInstance) {
 		}
 		defer resp.Body.Close()
 
-		var results []radarr.MovieResource
+		var results []map[string]any
 		json.NewDecoder(resp.Body).Decode(&results)
 		if len(results) == 0 {
 			return nil, fmt.Errorf("no results")
 		}
 
 		movie := results[0]
-		monitored := true
-		movie.Monitored = &monitored
-		qp := int32(1)
-		movie.QualityProfileId = &qp
-		rf := "/movies"
-		movie.RootFolderPath = &rf
-		ma := radarr.Announced
-		movie.MinimumAvailability = &ma
-		search := false
-		movie.AddOptions = &radarr.AddMovieOptions{SearchForMovie: &search}
+		movie["monitored"] = true
+		movie["qualityProfileId"] = 1
+		movie["rootFolderPath"] = "/movies"
+		movie["minimumAvailability"] = "announced"
+		movie["addOptions"] = map[string]any{"searchForMovie": false}
 
 		body, _ := json.Marshal(movie)
 		req, _ = http.NewRequestWithContext(ctx, "POST", inst.url+"/api/v3/movie", bytes.NewReader(body))
@@ -740,7 +734,7 @@ func runRadarrValidation(t *testing.T, ctx context.Context, inst testInstance) {
 			b, _ := io.ReadAll(resp.Body)
 			return nil, fmt.Errorf("status %d: %s", resp.StatusCode, b)
 		}
-		var added radarr.MovieResource
+		var added movieResource
 		json.NewDecoder(resp.Body).Decode(&added)
 		return &added, nil
 	}
@@ -806,7 +800,7 @@ func runRadarrValidation(t *testing.T, ctx context.Context, inst testInstance) {
 
 	// Restore
 	t.Log("Restoring...")
-	if err := client.Restore(ctx, bytes.NewReader(backupData)); err != nil {
+	if _, err := client.Restore(ctx, bytes.NewReader(backupData), backup.RestoreOptions{}); err != nil {
 		t.Fatalf("restore failed: %v", err)
 	}
 
@@ -861,7 +855,7 @@ func TestRestoreValidationProwlarr(t *testing.T) {
 	httpClient := &http.Client{Timeout: 30 * time.Second}
 
 	// Helper to get all tags
-	getTags := func() ([]prowlarr.TagResource, error) {
+	getTags := func() ([]tagResource, error) {
 		req, _ := http.NewRequestWithContext(ctx, "GET", inst.url+"/api/v1/tag", nil)
 		req.Header.Set("X-Api-Key", apiKey)
 		resp, err := httpClient.Do(req)
@@ -869,7 +863,7 @@ func TestRestoreValidationProwlarr(t *testing.T) {
 			return nil, err
 		}
 		defer resp.Body.Close()
-		var tags []prowlarr.TagResource
+		var tags []tagResource
 		if err := json.NewDecoder(resp.Body).Decode(&tags); err != nil {
 			return nil, err
 		}
@@ -877,8 +871,8 @@ func TestRestoreValidationProwlarr(t *testing.T) {
 	}
 
 	// Helper to add a tag
-	addTag := func(label string) (*prowlarr.TagResource, error) {
-		tag := prowlarr.TagResource{Label: &label}
+	addTag := func(label string) (*tagResource, error) {
+		tag := tagResource{Label: &label}
 		body, _ := json.Marshal(tag)
 		req, _ := http.NewRequestWithContext(ctx, "POST", inst.url+"/api/v1/tag", bytes.NewReader(body))
 		req.Header.Set("X-Api-Key", apiKey)
@@ -892,7 +886,7 @@ func TestRestoreValidationProwlarr(t *testing.T) {
 			respBody, _ := io.ReadAll(resp.Body)
 			return nil, fmt.Errorf("add tag failed with status %d: %s", resp.StatusCode, string(respBody))
 		}
-		var added prowlarr.TagResource
+		var added tagResource
 		if err := json.NewDecoder(resp.Body).Decode(&added); err != nil {
 			return nil, fmt.Errorf("decode failed: %w", err)
 		}
@@ -982,7 +976,7 @@ func TestRestoreValidationProwlarr(t *testing.T) {
 
 	// Step 6: Restore from backup
 	t.Log("Step 6: Restoring from backup...")
-	err = client.Restore(ctx, bytes.NewReader(backupData))
+	_, err = client.Restore(ctx, bytes.NewReader(backupData), backup.RestoreOptions{})
 	if err != nil {
 		t.Fatalf("failed to restore: %v", err)
 	}
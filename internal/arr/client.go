@@ -0,0 +1,666 @@
+// Package arr implements backup.Client for the Servarr family of apps
+// (Sonarr, Radarr, Lidarr, Readarr, Prowlarr, Whisparr). They share the
+// command, backup, host config and restart endpoints and forms login, and
+// differ only in the API version those endpoints live under.
+package arr
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"time"
+
+	"backuparr/internal/backup"
+)
+
+// Ensure Client implements backup.Client
+var _ backup.Client = (*Client)(nil)
+
+// App describes one *arr application.
+type App struct {
+	// Name is the app type ("sonarr"), used for logs and temp files.
+	Name string
+	// APIVersion is the path segment the endpoints live under: "v3" for
+	// Sonarr, Radarr and Whisparr, "v1" for Lidarr, Readarr and Prowlarr.
+	APIVersion string
+	// Postgres enables database type detection, the enhanced backup with
+	// pg_dump output and restoring those dumps.
+	Postgres bool
+}
+
+// Client talks to an *arr app with API key authentication, falling back to
+// a forms login session where the backup download requires one.
+type Client struct {
+	app        App
+	baseURL    string
+	apiKey     string
+	username   string
+	password   string
+	httpClient *http.Client           // Shared HTTP client with cookie jar for session auth
+	pgOverride *backup.PostgresConfig // Optional postgres config override
+}
+
+// New creates a client for app at baseURL.
+func New(app App, baseURL, apiKey, username, password string, pgOverride *backup.PostgresConfig) (*Client, error) {
+	// Create a cookie jar for session management
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cookie jar: %w", err)
+	}
+
+	return &Client{
+		app:      app,
+		baseURL:  strings.TrimSuffix(baseURL, "/"),
+		apiKey:   apiKey,
+		username: username,
+		password: password,
+		httpClient: &http.Client{
+			Timeout:   2 * time.Minute,
+			Jar:       jar,
+			Transport: backup.NewRetryTransport(nil),
+		},
+		pgOverride: pgOverride,
+	}, nil
+}
+
+// Name returns the application name
+func (c *Client) Name() string {
+	return c.app.Name
+}
+
+// logf prefixes a log line with the app name.
+func (c *Client) logf(format string, args ...any) {
+	log.Printf("[%s] "+format, append([]any{c.app.Name}, args...)...)
+}
+
+// Backup triggers a backup and returns the backup file content.
+//
+// The backup is spooled to a temporary file rather than held in memory.
+// For PostgreSQL instances the returned reader streams an enhanced zip
+// (original entries plus pg_dump output) produced on the fly.
+func (c *Client) Backup(ctx context.Context) (*backup.BackupResult, io.ReadCloser, error) {
+	// Trigger the backup command and wait for completion
+	if err := c.runBackupCommand(ctx); err != nil {
+		return nil, nil, fmt.Errorf("backup command failed: %w", err)
+	}
+
+	// Get the latest backup file
+	backups, err := c.getBackupFiles(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get backup files: %w", err)
+	}
+
+	if len(backups) == 0 {
+		return nil, nil, fmt.Errorf("no backup files found after backup command")
+	}
+
+	// Get the most recent backup (first in the list)
+	latest := backups[0]
+
+	// Download the backup file
+	reader, err := c.downloadBackup(ctx, latest.Path, latest.Size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to download backup: %w", err)
+	}
+
+	// Spool to disk so the download completes within the client timeout
+	// and the zip can be inspected without buffering it in memory
+	spool, err := backup.Spool(reader, c.app.Name+"-backup-*.zip")
+	reader.Close()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read backup data: %w", err)
+	}
+
+	result := &backup.BackupResult{
+		Name:      latest.Name,
+		Path:      latest.Path,
+		Size:      spool.Size(),
+		CreatedAt: latest.Time,
+	}
+
+	// The status also reports whether this instance uses PostgreSQL
+	status, err := c.getSystemStatus(ctx)
+	if err != nil {
+		c.logf("Warning: could not get system status: %v", err)
+	} else {
+		result.AppVersion = status.Version
+		result.DBType = backup.DBTypeSQLite
+	}
+
+	if !c.app.Postgres || status == nil || status.DatabaseType != databaseTypePostgres {
+		return result, spool, nil
+	}
+	result.DBType = backup.DBTypePostgres
+
+	c.logf("PostgreSQL detected, extracting connection info and dumping databases...")
+
+	zipReader, err := zip.NewReader(spool, spool.Size())
+	if err != nil {
+		spool.Close()
+		return nil, nil, fmt.Errorf("failed to open backup zip: %w", err)
+	}
+
+	// Parse Postgres config from the backup's config.xml
+	pgConfig, err := backup.ParsePostgresConfig(zipReader)
+	if err != nil {
+		spool.Close()
+		return nil, nil, fmt.Errorf("failed to parse postgres config: %w", err)
+	}
+
+	if pgConfig != nil && c.pgOverride != nil {
+		c.logf("Applying postgres config overrides from config.yml")
+		c.applyPostgresOverride(pgConfig, true)
+	} else if pgConfig == nil && c.pgOverride != nil {
+		// Use override as the full config if no config.xml found
+		pgConfig = c.pgOverride
+	}
+
+	if pgConfig == nil {
+		return result, spool, nil
+	}
+
+	c.logf("Using postgres host: %s:%s", pgConfig.Host, pgConfig.Port)
+	c.logf("Streaming enhanced backup with database dumps...")
+
+	// The enhanced size is not known until pg_dump finishes
+	result.Size = 0
+
+	enhanced := backup.StreamFrom(func(w io.Writer) error {
+		return backup.WriteEnhancedBackup(ctx, w, zipReader, pgConfig)
+	}, spool.Close)
+
+	return result, enhanced, nil
+}
+
+// applyPostgresOverride copies the non-empty fields of the configured
+// override onto pg. Database names are only overridden when dbNames is set:
+// on restore they must keep matching the dump file names in the backup.
+func (c *Client) applyPostgresOverride(pg *backup.PostgresConfig, dbNames bool) {
+	o := c.pgOverride
+	if o.Host != "" {
+		pg.Host = o.Host
+	}
+	if o.Port != "" {
+		pg.Port = o.Port
+	}
+	if o.User != "" {
+		pg.User = o.User
+	}
+	if o.Password != "" {
+		pg.Password = o.Password
+	}
+	if o.Format != "" {
+		pg.Format = o.Format
+	}
+	if o.Jobs != 0 {
+		pg.Jobs = o.Jobs
+	}
+	if o.Engine != "" {
+		pg.Engine = o.Engine
+	}
+	if !dbNames {
+		return
+	}
+	if o.MainDB != "" {
+		pg.MainDB = o.MainDB
+	}
+	if o.LogDB != "" {
+		pg.LogDB = o.LogDB
+	}
+}
+
+// Restore restores the application from a backup file. With opts.DryRun it
+// only parses the archive and reports the Postgres databases that would be
+// overwritten and whether a restart would follow.
+func (c *Client) Restore(ctx context.Context, backupData io.Reader, opts backup.RestoreOptions) (*backup.RestorePlan, error) {
+	c.logf("Reading backup data...")
+
+	// Read all backup data into memory so we can analyze it
+	zipData, err := io.ReadAll(backupData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup data: %w", err)
+	}
+
+	if _, err := zip.NewReader(bytes.NewReader(zipData), int64(len(zipData))); err != nil {
+		return nil, fmt.Errorf("failed to open backup zip: %w", err)
+	}
+
+	plan := &backup.RestorePlan{
+		App:    c.app.Name,
+		DBType: backup.DBTypeSQLite,
+		// The restore response says whether a restart is needed; it normally is.
+		Restart:       true,
+		RestartMethod: c.app.Name + " API",
+		Notes:         []string{"backup is uploaded to " + c.baseURL + " and replaces the current configuration and database"},
+	}
+
+	var pgConfig *backup.PostgresConfig
+	var pgDumps map[string][]byte
+	if c.app.Postgres {
+		// Check if backup contains PostgreSQL dumps
+		pgDumps, err = backup.ExtractPostgresDumpsFromZip(zipData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract postgres dumps: %w", err)
+		}
+	}
+
+	if len(pgDumps) > 0 {
+		c.logf("PostgreSQL backup detected with %d database dumps", len(pgDumps))
+
+		// Parse postgres config from the backup's config.xml
+		pgConfig, err = backup.ParsePostgresConfigFromZip(zipData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse postgres config from backup: %w", err)
+		}
+
+		if pgConfig == nil {
+			return nil, fmt.Errorf("backup contains postgres dumps but config.xml has no postgres settings")
+		}
+
+		if c.pgOverride != nil {
+			c.applyPostgresOverride(pgConfig, false)
+			c.logf("Applying postgres config overrides, using host: %s:%s", pgConfig.Host, pgConfig.Port)
+		}
+		if opts.Postgres != nil {
+			pgConfig.Retarget(opts.Postgres)
+			c.logf("Retargeting restore to %s:%s, databases %s and %s", pgConfig.Host, pgConfig.Port, pgConfig.MainDB, pgConfig.LogDB)
+			plan.Notes = append(plan.Notes, "config.xml is rewritten to use the retargeted Postgres settings before upload")
+		}
+
+		dumpFiles := make([]string, 0, len(pgDumps))
+		for filename := range pgDumps {
+			dumpFiles = append(dumpFiles, filename)
+		}
+		plan.DBType = backup.DBTypePostgres
+		plan.Postgres, err = pgConfig.RestorePlan(dumpFiles)
+		if err != nil {
+			return nil, err
+		}
+	} else if c.app.Postgres {
+		c.logf("No PostgreSQL dumps found in backup (SQLite-only backup)")
+	}
+
+	if opts.Postgres != nil && pgConfig == nil {
+		return nil, fmt.Errorf("cannot retarget postgres restore: backup contains no postgres dumps")
+	}
+
+	if opts.DryRun {
+		return plan, nil
+	}
+
+	if pgConfig != nil {
+		// Restore PostgreSQL databases
+		c.logf("Restoring PostgreSQL databases...")
+		for filename, data := range pgDumps {
+			c.logf("Restoring %s (%d bytes)...", filename, len(data))
+		}
+
+		if err := pgConfig.RestoreAllDatabases(pgDumps); err != nil {
+			return nil, fmt.Errorf("failed to restore postgres databases: %w", err)
+		}
+		c.logf("PostgreSQL databases restored successfully")
+	}
+
+	if opts.Postgres != nil {
+		zipData, err = backup.RewriteConfigXML(zipData, opts.Postgres)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rewrite config.xml: %w", err)
+		}
+	}
+
+	// Now upload the backup to the API (handles config.xml)
+	c.logf("Uploading backup for restore...")
+
+	restartRequired, err := c.uploadRestore(ctx, zipData)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logf("Backup uploaded successfully. Restart required: %v", restartRequired)
+	plan.Restart = restartRequired
+
+	if restartRequired {
+		c.logf("Triggering application restart...")
+		if err := c.restart(ctx); err != nil {
+			return nil, fmt.Errorf("failed to restart after restore: %w", err)
+		}
+		c.logf("Restart triggered successfully")
+	}
+
+	return plan, nil
+}
+
+// Internal methods
+
+// apiURL returns the URL of an endpoint under /api/<version>/.
+func (c *Client) apiURL(endpoint string) string {
+	return fmt.Sprintf("%s/api/%s/%s", c.baseURL, c.app.APIVersion, endpoint)
+}
+
+// do sends an API request and decodes a 2xx JSON response into out (when
+// non-nil).
+func (c *Client) do(ctx context.Context, method, endpoint string, body, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.apiURL(endpoint), reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-Api-Key", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error: %d - %s", resp.StatusCode, string(body))
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) runBackupCommand(ctx context.Context) error {
+	var cmdResp commandResource
+	if err := c.do(ctx, http.MethodPost, "command", commandResource{Name: "Backup"}, &cmdResp); err != nil {
+		return fmt.Errorf("failed to send command: %w", err)
+	}
+
+	if cmdResp.ID == nil {
+		return fmt.Errorf("command response has no ID")
+	}
+
+	// Poll for command completion
+	return c.waitForCommand(ctx, *cmdResp.ID)
+}
+
+func (c *Client) waitForCommand(ctx context.Context, commandID int32) error {
+	for {
+		var cmdResp commandResource
+		if err := c.do(ctx, http.MethodGet, fmt.Sprintf("command/%d", commandID), nil, &cmdResp); err != nil {
+			return fmt.Errorf("failed to get command status: %w", err)
+		}
+
+		if cmdResp.Status != "" {
+			c.logf("Command status: %s", cmdResp.Status)
+
+			switch cmdResp.Status {
+			case commandStatusCompleted:
+				return nil
+			case commandStatusFailed:
+				return fmt.Errorf("command failed: %s", cmdResp.Message)
+			case commandStatusCancelled:
+				return fmt.Errorf("command was cancelled")
+			case commandStatusAborted:
+				return fmt.Errorf("command was aborted")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+			// Continue polling
+		}
+	}
+}
+
+func (c *Client) getBackupFiles(ctx context.Context) ([]backupResource, error) {
+	var backups []backupResource
+	if err := c.do(ctx, http.MethodGet, "system/backup", nil, &backups); err != nil {
+		return nil, fmt.Errorf("failed to get backups: %w", err)
+	}
+	return backups, nil
+}
+
+func (c *Client) getSystemStatus(ctx context.Context) (*systemResource, error) {
+	var status systemResource
+	if err := c.do(ctx, http.MethodGet, "system/status", nil, &status); err != nil {
+		return nil, fmt.Errorf("failed to get system status: %w", err)
+	}
+	return &status, nil
+}
+
+func (c *Client) getAuthMethod(ctx context.Context) (string, error) {
+	var config hostConfigResource
+	if err := c.do(ctx, http.MethodGet, "config/host", nil, &config); err != nil {
+		return "", fmt.Errorf("failed to get host config: %w", err)
+	}
+
+	if config.AuthenticationMethod == "" {
+		return "none", nil
+	}
+	return config.AuthenticationMethod, nil
+}
+
+func (c *Client) downloadBackup(ctx context.Context, backupPath string, expectedSize int64) (io.ReadCloser, error) {
+	if backupPath == "" {
+		return nil, fmt.Errorf("backup path is empty")
+	}
+
+	// Get the authentication method from config
+	authMethod, err := c.getAuthMethod(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auth method: %w", err)
+	}
+
+	c.logf("Authentication method: %s", authMethod)
+
+	// Handle authentication based on method
+	switch strings.ToLower(authMethod) {
+	case "forms":
+		if err := c.loginWithForms(ctx); err != nil {
+			return nil, fmt.Errorf("forms login failed: %w", err)
+		}
+	case "basic":
+		// Basic auth will be handled in the request
+	case "none", "external":
+		// No authentication needed or handled externally
+	default:
+		c.logf("Unknown auth method: %s, proceeding without session auth", authMethod)
+	}
+
+	// Download the backup using the session
+	downloadURL := c.baseURL + backupPath
+	c.logf("Downloading backup from: %s", downloadURL)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", downloadURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Add API key header as backup
+	req.Header.Set("X-Api-Key", c.apiKey)
+
+	// For basic auth, add the credentials
+	if strings.ToLower(authMethod) == "basic" && c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.transferClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download backup: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("download error: %d - %s", resp.StatusCode, string(body))
+	}
+
+	// Verify content type is a zip file
+	contentType := resp.Header.Get("Content-Type")
+	validTypes := []string{"application/zip", "application/octet-stream", "application/x-zip-compressed", "application/x-zip"}
+	isValidType := contentType == ""
+	for _, t := range validTypes {
+		if contentType == t {
+			isValidType = true
+			break
+		}
+	}
+	if !isValidType {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected content type: %s (expected application/zip)", contentType)
+	}
+
+	// Verify content length matches expected size
+	if resp.ContentLength > 0 && expectedSize > 0 && resp.ContentLength != expectedSize {
+		c.logf("Content length mismatch: got %d, expected %d (continuing anyway)", resp.ContentLength, expectedSize)
+	}
+
+	return resp.Body, nil
+}
+
+// transferClient returns a client with a longer timeout for backup
+// downloads and uploads that shares the session cookie jar.
+func (c *Client) transferClient() *http.Client {
+	return &http.Client{
+		Timeout:   5 * time.Minute,
+		Jar:       c.httpClient.Jar,
+		Transport: backup.NewRetryTransport(nil),
+	}
+}
+
+func (c *Client) loginWithForms(ctx context.Context) error {
+	loginURL := fmt.Sprintf("%s/login", c.baseURL)
+
+	formData := url.Values{}
+	formData.Set("username", c.username)
+	formData.Set("password", c.password)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", loginURL, strings.NewReader(formData.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create login request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	// Use a client that doesn't follow redirects so we can inspect the response
+	noRedirectClient := &http.Client{
+		Timeout:   2 * time.Minute,
+		Jar:       c.httpClient.Jar,
+		Transport: backup.NewRetryTransport(nil),
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := noRedirectClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("login request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 401 {
+		return fmt.Errorf("login failed: invalid credentials")
+	}
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("login failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	// Verify the auth cookie was actually set — the *arr apps return 200/302
+	// even on failed login, but only set the auth cookie on success
+	parsedURL, err := url.Parse(c.baseURL)
+	if err != nil {
+		return fmt.Errorf("failed to parse base URL: %w", err)
+	}
+
+	var hasAuthCookie bool
+	for _, cookie := range c.httpClient.Jar.Cookies(parsedURL) {
+		if strings.HasSuffix(cookie.Name, "Auth") {
+			hasAuthCookie = true
+			break
+		}
+	}
+
+	if !hasAuthCookie {
+		return fmt.Errorf("login failed: no auth cookie received (check username/password)")
+	}
+
+	c.logf("Forms login successful")
+	return nil
+}
+
+// uploadRestore uploads the backup to the restore endpoint and reports
+// whether the app asked to be restarted.
+func (c *Client) uploadRestore(ctx context.Context, zipData []byte) (bool, error) {
+	// Create multipart form data
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	// Create form file field named "restore"
+	part, err := writer.CreateFormFile("restore", "backup.zip")
+	if err != nil {
+		return false, fmt.Errorf("failed to create form file: %w", err)
+	}
+
+	// Write backup data to form field
+	if _, err := part.Write(zipData); err != nil {
+		return false, fmt.Errorf("failed to write backup data: %w", err)
+	}
+
+	// Close the multipart writer to finalize the form
+	if err := writer.Close(); err != nil {
+		return false, fmt.Errorf("failed to close multipart writer: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiURL("system/backup/restore/upload"), &buf)
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-Api-Key", c.apiKey)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := c.transferClient().Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to upload backup: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return false, fmt.Errorf("restore upload failed: %d - %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		RestartRequired bool `json:"RestartRequired"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		c.logf("Warning: failed to parse restore response: %v", err)
+	}
+	return result.RestartRequired, nil
+}
+
+func (c *Client) restart(ctx context.Context) error {
+	if err := c.do(ctx, http.MethodPost, "system/restart", nil, nil); err != nil {
+		return fmt.Errorf("restart command failed: %w", err)
+	}
+	return nil
+}
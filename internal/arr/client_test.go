@@ -0,0 +1,138 @@
+package arr
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"backuparr/internal/backup"
+)
+
+func makeZip(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	fw, _ := zw.Create("config.xml")
+	fw.Write([]byte("<Config><ApiKey>test123</ApiKey></Config>"))
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// newServer serves the backup endpoints under /api/<version>/ for an
+// instance reporting dbType, and fails the test on any other API path.
+func newServer(t *testing.T, version, dbType string) *httptest.Server {
+	t.Helper()
+	prefix := "/api/" + version
+	writeJSON := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(v)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+prefix+"/command", func(w http.ResponseWriter, r *http.Request) {
+		var cmd commandResource
+		json.NewDecoder(r.Body).Decode(&cmd)
+		if cmd.Name != "Backup" || r.Header.Get("X-Api-Key") != "k" {
+			t.Errorf("command %+v with key %q", cmd, r.Header.Get("X-Api-Key"))
+		}
+		id := int32(3)
+		writeJSON(w, commandResource{ID: &id, Status: "queued"})
+	})
+	mux.HandleFunc("GET "+prefix+"/command/3", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, commandResource{Status: commandStatusCompleted})
+	})
+	mux.HandleFunc("GET "+prefix+"/system/backup", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []backupResource{{Name: "backup.zip", Path: "/backup/manual/backup.zip"}})
+	})
+	mux.HandleFunc("GET "+prefix+"/system/status", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, systemResource{Version: "5.0.0", DatabaseType: dbType})
+	})
+	mux.HandleFunc("GET "+prefix+"/config/host", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, hostConfigResource{AuthenticationMethod: "external"})
+	})
+	mux.HandleFunc("GET /backup/manual/backup.zip", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/zip")
+		w.Write(makeZip(t))
+	})
+	mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		http.NotFound(w, r)
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestNew(t *testing.T) {
+	c, err := New(App{Name: "sonarr", APIVersion: "v3"}, "http://localhost:8989/", "test-key", "user", "pass", nil)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if c.Name() != "sonarr" || c.apiKey != "test-key" || c.username != "user" {
+		t.Errorf("client = %+v", c)
+	}
+	if got := c.apiURL("system/backup"); got != "http://localhost:8989/api/v3/system/backup" {
+		t.Errorf("apiURL = %q", got)
+	}
+}
+
+func TestBackup_APIVersion(t *testing.T) {
+	for _, version := range []string{"v1", "v3"} {
+		t.Run(version, func(t *testing.T) {
+			srv := newServer(t, version, "sqLite")
+			c, _ := New(App{Name: "test", APIVersion: version, Postgres: true}, srv.URL, "k", "", "", nil)
+
+			result, reader, err := c.Backup(context.Background())
+			if err != nil {
+				t.Fatalf("Backup: %v", err)
+			}
+			defer reader.Close()
+			if result.AppVersion != "5.0.0" || result.DBType != backup.DBTypeSQLite {
+				t.Errorf("result = %+v", result)
+			}
+		})
+	}
+}
+
+func TestBackup_PostgresDisabled(t *testing.T) {
+	// Without App.Postgres the database type is ignored and the app's own
+	// archive is returned unchanged, so no pg_dump is attempted.
+	srv := newServer(t, "v1", databaseTypePostgres)
+	c, _ := New(App{Name: "test", APIVersion: "v1"}, srv.URL, "k", "", "", nil)
+
+	_, reader, err := c.Backup(context.Background())
+	if err != nil {
+		t.Fatalf("Backup: %v", err)
+	}
+	defer reader.Close()
+	data, _ := io.ReadAll(reader)
+	if !bytes.Equal(data, makeZip(t)) {
+		t.Error("backup was modified")
+	}
+}
+
+func TestApplyPostgresOverride(t *testing.T) {
+	override := &backup.PostgresConfig{Host: "db.lan", Password: "secret", MainDB: "renamed-main"}
+	c, _ := New(App{Name: "radarr", APIVersion: "v3", Postgres: true}, "http://radarr", "k", "", "", override)
+
+	pg := &backup.PostgresConfig{Host: "postgres", Port: "5432", User: "radarr", Password: "old", MainDB: "radarr-main", LogDB: "radarr-log"}
+	c.applyPostgresOverride(pg, false)
+	want := backup.PostgresConfig{Host: "db.lan", Port: "5432", User: "radarr", Password: "secret", MainDB: "radarr-main", LogDB: "radarr-log"}
+	if *pg != want {
+		t.Errorf("restore override = %+v, want %+v", *pg, want)
+	}
+
+	c.applyPostgresOverride(pg, true)
+	want.MainDB = "renamed-main"
+	if *pg != want {
+		t.Errorf("backup override = %+v, want %+v", *pg, want)
+	}
+}
@@ -0,0 +1,38 @@
+package arr
+
+import "time"
+
+// The subset of the Servarr API resources the client reads. Field names and
+// values are shared by every app and API version.
+
+const (
+	commandStatusCompleted = "completed"
+	commandStatusFailed    = "failed"
+	commandStatusCancelled = "cancelled"
+	commandStatusAborted   = "aborted"
+
+	databaseTypePostgres = "postgreSQL"
+)
+
+type commandResource struct {
+	ID      *int32 `json:"id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type backupResource struct {
+	Name string    `json:"name"`
+	Path string    `json:"path"`
+	Size int64     `json:"size"`
+	Time time.Time `json:"time"`
+}
+
+type systemResource struct {
+	Version      string `json:"version"`
+	DatabaseType string `json:"databaseType"`
+}
+
+type hostConfigResource struct {
+	AuthenticationMethod string `json:"authenticationMethod"`
+}
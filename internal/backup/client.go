@@ -2,7 +2,9 @@ package backup
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"strings"
 	"time"
 )
 
@@ -12,8 +14,20 @@ type BackupResult struct {
 	Path      string
 	Size      int64
 	CreatedAt time.Time
+
+	// AppVersion and DBType are recorded in the backup manifest when the
+	// client can determine them; either may be empty.
+	AppVersion string
+	DBType     string
 }
 
+// Database types reported in BackupResult.DBType.
+const (
+	DBTypeSQLite   = "sqlite"
+	DBTypePostgres = "postgres"
+	DBTypeMySQL    = "mysql"
+)
+
 // Client defines the high-level interface for any application that supports backup operations.
 // Storage routing (local, S3, PBS, etc.) is handled by the orchestrator, not the client.
 type Client interface {
@@ -25,6 +39,103 @@ type Client interface {
 	Backup(ctx context.Context) (*BackupResult, io.ReadCloser, error)
 
 	// Restore restores the application from a backup file.
-	// The reader should contain the backup file content.
-	Restore(ctx context.Context, backup io.Reader) error
+	// The reader should contain the backup file content. It returns a plan
+	// describing what was changed, or with opts.DryRun, what would be
+	// changed; a dry run must not modify the application.
+	Restore(ctx context.Context, backup io.Reader, opts RestoreOptions) (*RestorePlan, error)
+}
+
+// Cleaner is implemented by clients whose backups leave a copy behind on
+// the application, like an archive in its backups directory. Cleanup is
+// called with the result of Backup once the backup has been stored on at
+// least one backend, and never when every upload failed.
+type Cleaner interface {
+	Cleanup(ctx context.Context, result *BackupResult) error
+}
+
+// RestoreOptions controls how a Client applies a backup.
+type RestoreOptions struct {
+	// DryRun analyses the backup and reports the plan without changing anything.
+	DryRun bool
+
+	// Postgres retargets a Postgres restore, e.g. into staging databases:
+	// its non-empty fields replace the server and database names from the
+	// archived config.xml, and config.xml is rewritten to match before the
+	// backup is uploaded. Nil restores to the archived databases.
+	Postgres *PostgresConfig
+}
+
+// RestorePlan describes the changes a restore makes to an application.
+type RestorePlan struct {
+	App    string `json:"app"`
+	DBType string `json:"dbType,omitempty"`
+
+	// Postgres is set when the backup contains database dumps. Every table
+	// in the public schema of each listed database is dropped and recreated.
+	Postgres *PostgresRestorePlan `json:"postgres,omitempty"`
+
+	// Files lists files written to the target (sidecar restores).
+	Files []FileChange `json:"files,omitempty"`
+
+	// Restart is true if the application is restarted (or rebooted) after
+	// the restore. RestartMethod describes how, when known.
+	Restart       bool   `json:"restart"`
+	RestartMethod string `json:"restartMethod,omitempty"`
+
+	// Notes are human-readable remarks about the restore.
+	Notes []string `json:"notes,omitempty"`
+}
+
+// PostgresRestorePlan identifies the databases a restore overwrites.
+type PostgresRestorePlan struct {
+	Host      string   `json:"host"`
+	Port      string   `json:"port"`
+	User      string   `json:"user"`
+	Databases []string `json:"databases"`
+}
+
+// File change actions.
+const (
+	FileCreate    = "create"
+	FileOverwrite = "overwrite"
+)
+
+// FileChange is a single file a restore creates or overwrites.
+type FileChange struct {
+	Path   string `json:"path"`
+	Action string `json:"action"`
+	Size   int64  `json:"size"`
+}
+
+// String renders the plan as indented, human-readable lines.
+func (p *RestorePlan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "  App:      %s\n", p.App)
+	if p.DBType != "" {
+		fmt.Fprintf(&b, "  Database: %s\n", p.DBType)
+	}
+	if pg := p.Postgres; pg != nil {
+		fmt.Fprintf(&b, "  Postgres: %s@%s:%s\n", pg.User, pg.Host, pg.Port)
+		for _, db := range pg.Databases {
+			fmt.Fprintf(&b, "    DROP all tables in %s.public, then load dump\n", db)
+		}
+	}
+	if len(p.Files) > 0 {
+		fmt.Fprintf(&b, "  Files:    %d\n", len(p.Files))
+		for _, f := range p.Files {
+			fmt.Fprintf(&b, "    %-9s %s (%d bytes)\n", f.Action, f.Path, f.Size)
+		}
+	}
+	restart := "no"
+	if p.Restart {
+		restart = "yes"
+		if p.RestartMethod != "" {
+			restart += " (" + p.RestartMethod + ")"
+		}
+	}
+	fmt.Fprintf(&b, "  Restart:  %s\n", restart)
+	for _, n := range p.Notes {
+		fmt.Fprintf(&b, "  Note:     %s\n", n)
+	}
+	return b.String()
 }
@@ -0,0 +1,554 @@
+package backup
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// The native engine covers what the Servarr apps create in their databases:
+// tables, sequences (including identity and serial columns), constraints,
+// indexes and views in the public schema. Databases holding anything else
+// there (functions, triggers, custom types) are rejected rather than dumped
+// incompletely; use the pgtools engine for those.
+
+// pgColumn is a table column as read from pg_attribute.
+type pgColumn struct {
+	Name     string
+	Type     string
+	NotNull  bool
+	Default  string
+	Identity string // "a" (ALWAYS), "d" (BY DEFAULT) or ""
+	// Generated holds the expression of a stored generated column.
+	Generated string
+}
+
+type pgTable struct {
+	Name    string
+	Columns []pgColumn
+}
+
+// copyColumns returns the quoted column list used by COPY; generated
+// columns are computed by the server and cannot be copied.
+func (t *pgTable) copyColumns() string {
+	var cols []string
+	for _, col := range t.Columns {
+		if col.Generated == "" {
+			cols = append(cols, quoteIdent(col.Name))
+		}
+	}
+	if len(cols) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(cols, ", ") + ")"
+}
+
+type pgSequence struct {
+	Name                    string
+	Type                    string
+	Start, Increment        int64
+	Min, Max, Cache         int64
+	Cycle                   bool
+	OwnerTable, OwnerColumn string
+	Identity                bool
+	LastValue               int64
+	IsCalled                bool
+}
+
+func (s *pgSequence) options() string {
+	opts := fmt.Sprintf("START WITH %d INCREMENT BY %d MINVALUE %d MAXVALUE %d CACHE %d", s.Start, s.Increment, s.Min, s.Max, s.Cache)
+	if s.Cycle {
+		opts += " CYCLE"
+	}
+	return opts
+}
+
+type pgConstraint struct {
+	Table, Name, Type, Def string
+}
+
+type pgView struct {
+	Name, Def string
+}
+
+// pgSchema is everything the native engine dumps besides table data.
+type pgSchema struct {
+	Sequences   []pgSequence
+	Tables      []pgTable
+	Constraints []pgConstraint
+	Indexes     []string
+	Views       []pgView
+}
+
+func quoteIdent(name string) string {
+	return pgx.Identifier{name}.Sanitize()
+}
+
+func qualified(name string) string {
+	return pgx.Identifier{"public", name}.Sanitize()
+}
+
+// identitySequence returns the identity sequence owned by table.column.
+func (s *pgSchema) identitySequence(table, column string) *pgSequence {
+	for i := range s.Sequences {
+		seq := &s.Sequences[i]
+		if seq.Identity && seq.OwnerTable == table && seq.OwnerColumn == column {
+			return seq
+		}
+	}
+	return nil
+}
+
+const nativeDumpHeader = `--
+-- PostgreSQL database dump (backuparr native engine)
+--
+
+SET statement_timeout = 0;
+SET lock_timeout = 0;
+SET client_encoding = 'UTF8';
+SET standard_conforming_strings = on;
+SELECT pg_catalog.set_config('search_path', '', false);
+SET check_function_bodies = false;
+SET client_min_messages = warning;
+
+`
+
+// writePreData writes the sequences and tables that must exist before the
+// data is loaded.
+func (s *pgSchema) writePreData(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString(nativeDumpHeader)
+
+	for _, seq := range s.Sequences {
+		if seq.Identity {
+			continue
+		}
+		fmt.Fprintf(&b, "CREATE SEQUENCE %s AS %s %s;\n\n", qualified(seq.Name), seq.Type, seq.options())
+	}
+
+	for _, t := range s.Tables {
+		fmt.Fprintf(&b, "CREATE TABLE %s (", qualified(t.Name))
+		for i, col := range t.Columns {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			fmt.Fprintf(&b, "\n    %s %s", quoteIdent(col.Name), col.Type)
+			switch {
+			case col.Generated != "":
+				fmt.Fprintf(&b, " GENERATED ALWAYS AS (%s) STORED", col.Generated)
+			case col.Identity != "":
+				kind := "BY DEFAULT"
+				if col.Identity == "a" {
+					kind = "ALWAYS"
+				}
+				fmt.Fprintf(&b, " GENERATED %s AS IDENTITY", kind)
+				if seq := s.identitySequence(t.Name, col.Name); seq != nil {
+					fmt.Fprintf(&b, " (SEQUENCE NAME %s %s)", qualified(seq.Name), seq.options())
+				}
+			case col.Default != "":
+				fmt.Fprintf(&b, " DEFAULT %s", col.Default)
+			}
+			if col.NotNull {
+				b.WriteString(" NOT NULL")
+			}
+		}
+		b.WriteString("\n);\n\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writePostData writes sequence positions and ownership, constraints,
+// indexes and views, which are cheaper to build once the data is loaded.
+func (s *pgSchema) writePostData(w io.Writer) error {
+	var b strings.Builder
+
+	for _, seq := range s.Sequences {
+		if seq.OwnerTable != "" && !seq.Identity {
+			fmt.Fprintf(&b, "ALTER SEQUENCE %s OWNED BY %s.%s;\n", qualified(seq.Name), qualified(seq.OwnerTable), quoteIdent(seq.OwnerColumn))
+		}
+		fmt.Fprintf(&b, "SELECT pg_catalog.setval('%s', %d, %t);\n", strings.ReplaceAll(qualified(seq.Name), "'", "''"), seq.LastValue, seq.IsCalled)
+	}
+	if len(s.Sequences) > 0 {
+		b.WriteString("\n")
+	}
+
+	for _, con := range s.Constraints {
+		fmt.Fprintf(&b, "ALTER TABLE ONLY %s\n    ADD CONSTRAINT %s %s;\n\n", qualified(con.Table), quoteIdent(con.Name), con.Def)
+	}
+	for _, idx := range s.Indexes {
+		fmt.Fprintf(&b, "%s;\n\n", idx)
+	}
+	for _, v := range s.Views {
+		fmt.Fprintf(&b, "CREATE VIEW %s AS\n%s\n\n", qualified(v.Name), strings.TrimSpace(v.Def))
+	}
+
+	b.WriteString("--\n-- PostgreSQL database dump complete\n--\n\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// connect opens a connection to dbName using the configured credentials.
+func (c *PostgresConfig) connect(ctx context.Context, dbName string) (*pgx.Conn, error) {
+	port := c.Port
+	if port == "" {
+		port = "5432"
+	}
+	u := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(c.User, c.Password),
+		Host:   net.JoinHostPort(c.Host, port),
+		Path:   "/" + dbName,
+	}
+	conn, err := pgx.Connect(ctx, u.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", dbName, err)
+	}
+	return conn, nil
+}
+
+// dumpNative writes a plain SQL dump of dbName to w, in the same shape as
+// pg_dump --format=plain so it restores with either engine. Everything is
+// read in one repeatable-read transaction for a consistent snapshot.
+func (c *PostgresConfig) dumpNative(ctx context.Context, dbName string, w io.Writer) error {
+	conn, err := c.connect(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return fmt.Errorf("failed to begin dump transaction: %w", err)
+	}
+	defer tx.Rollback(context.Background())
+
+	// An empty search_path makes the catalog functions schema-qualify every
+	// name they print, as pg_dump does.
+	if _, err := tx.Exec(ctx, "SELECT pg_catalog.set_config('search_path', '', true)"); err != nil {
+		return fmt.Errorf("failed to set search_path: %w", err)
+	}
+	if err := checkNativeSupported(ctx, tx); err != nil {
+		return err
+	}
+
+	schema, err := readSchema(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	if err := schema.writePreData(w); err != nil {
+		return fmt.Errorf("failed to write dump: %w", err)
+	}
+	for _, t := range schema.Tables {
+		cols := t.copyColumns()
+		if _, err := fmt.Fprintf(w, "COPY %s%s FROM stdin;\n", qualified(t.Name), cols); err != nil {
+			return fmt.Errorf("failed to write dump: %w", err)
+		}
+		if _, err := conn.PgConn().CopyTo(ctx, w, fmt.Sprintf("COPY %s%s TO STDOUT", qualified(t.Name), cols)); err != nil {
+			return fmt.Errorf("failed to copy %s: %w", t.Name, err)
+		}
+		if _, err := io.WriteString(w, "\\.\n\n"); err != nil {
+			return fmt.Errorf("failed to write dump: %w", err)
+		}
+	}
+	if err := schema.writePostData(w); err != nil {
+		return fmt.Errorf("failed to write dump: %w", err)
+	}
+	return nil
+}
+
+// checkNativeSupported fails if the public schema holds objects the native
+// engine does not dump. Objects belonging to extensions are ignored.
+func checkNativeSupported(ctx context.Context, tx pgx.Tx) error {
+	var functions, triggers, types int
+	err := tx.QueryRow(ctx, `
+SELECT
+  (SELECT count(*) FROM pg_catalog.pg_proc p
+     JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+    WHERE n.nspname = 'public'
+      AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_depend d WHERE d.objid = p.oid AND d.deptype = 'e')),
+  (SELECT count(*) FROM pg_catalog.pg_trigger tg
+     JOIN pg_catalog.pg_class c ON c.oid = tg.tgrelid
+     JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+    WHERE n.nspname = 'public' AND NOT tg.tgisinternal),
+  (SELECT count(*) FROM pg_catalog.pg_type ty
+     JOIN pg_catalog.pg_namespace n ON n.oid = ty.typnamespace
+    WHERE n.nspname = 'public' AND ty.typtype IN ('e', 'd', 'r')
+      AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_depend d WHERE d.objid = ty.oid AND d.deptype = 'e'))`).Scan(&functions, &triggers, &types)
+	if err != nil {
+		return fmt.Errorf("failed to inspect schema: %w", err)
+	}
+	if functions+triggers+types > 0 {
+		return fmt.Errorf("native engine cannot dump %d functions, %d triggers and %d custom types in schema public; use the %q engine", functions, triggers, types, EnginePgTools)
+	}
+	return nil
+}
+
+// readSchema loads the public schema definition from the catalogs.
+func readSchema(ctx context.Context, tx pgx.Tx) (*pgSchema, error) {
+	s := &pgSchema{}
+
+	rows, err := tx.Query(ctx, `
+SELECT c.relname, pg_catalog.format_type(s.seqtypid, NULL), s.seqstart, s.seqincrement,
+       s.seqmin, s.seqmax, s.seqcache, s.seqcycle,
+       COALESCE(d.deptype::text, ''), COALESCE(t.relname, ''), COALESCE(a.attname, '')
+  FROM pg_catalog.pg_sequence s
+  JOIN pg_catalog.pg_class c ON c.oid = s.seqrelid
+  JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+  LEFT JOIN pg_catalog.pg_depend d ON d.classid = 'pg_catalog.pg_class'::pg_catalog.regclass
+       AND d.objid = c.oid AND d.refclassid = 'pg_catalog.pg_class'::pg_catalog.regclass
+       AND d.deptype IN ('a', 'i')
+  LEFT JOIN pg_catalog.pg_class t ON t.oid = d.refobjid
+  LEFT JOIN pg_catalog.pg_attribute a ON a.attrelid = d.refobjid AND a.attnum = d.refobjsubid
+ WHERE n.nspname = 'public'
+ ORDER BY c.relname`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sequences: %w", err)
+	}
+	for rows.Next() {
+		var seq pgSequence
+		var deptype string
+		if err := rows.Scan(&seq.Name, &seq.Type, &seq.Start, &seq.Increment, &seq.Min, &seq.Max, &seq.Cache, &seq.Cycle,
+			&deptype, &seq.OwnerTable, &seq.OwnerColumn); err != nil {
+			return nil, fmt.Errorf("failed to list sequences: %w", err)
+		}
+		seq.Identity = deptype == "i"
+		s.Sequences = append(s.Sequences, seq)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list sequences: %w", err)
+	}
+	for i := range s.Sequences {
+		seq := &s.Sequences[i]
+		err := tx.QueryRow(ctx, "SELECT last_value, is_called FROM "+qualified(seq.Name)).Scan(&seq.LastValue, &seq.IsCalled)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read sequence %s: %w", seq.Name, err)
+		}
+	}
+
+	rows, err = tx.Query(ctx, `
+SELECT c.relname, a.attname, pg_catalog.format_type(a.atttypid, a.atttypmod), a.attnotnull,
+       COALESCE(pg_catalog.pg_get_expr(d.adbin, d.adrelid), ''), a.attidentity::text, a.attgenerated::text
+  FROM pg_catalog.pg_class c
+  JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+  JOIN pg_catalog.pg_attribute a ON a.attrelid = c.oid AND a.attnum > 0 AND NOT a.attisdropped
+  LEFT JOIN pg_catalog.pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+ WHERE n.nspname = 'public' AND c.relkind = 'r'
+ ORDER BY c.relname, a.attnum`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	for rows.Next() {
+		var table, generated string
+		var col pgColumn
+		if err := rows.Scan(&table, &col.Name, &col.Type, &col.NotNull, &col.Default, &col.Identity, &generated); err != nil {
+			return nil, fmt.Errorf("failed to list tables: %w", err)
+		}
+		if generated == "s" {
+			col.Generated, col.Default = col.Default, ""
+		}
+		if n := len(s.Tables); n == 0 || s.Tables[n-1].Name != table {
+			s.Tables = append(s.Tables, pgTable{Name: table})
+		}
+		t := &s.Tables[len(s.Tables)-1]
+		t.Columns = append(t.Columns, col)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+
+	// Foreign keys go last so the keys they reference already exist.
+	rows, err = tx.Query(ctx, `
+SELECT t.relname, con.conname, con.contype::text, pg_catalog.pg_get_constraintdef(con.oid)
+  FROM pg_catalog.pg_constraint con
+  JOIN pg_catalog.pg_class t ON t.oid = con.conrelid
+  JOIN pg_catalog.pg_namespace n ON n.oid = t.relnamespace
+ WHERE n.nspname = 'public' AND t.relkind = 'r' AND con.contype IN ('p', 'u', 'c', 'x', 'f')
+ ORDER BY con.contype = 'f', t.relname, con.conname`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list constraints: %w", err)
+	}
+	for rows.Next() {
+		var con pgConstraint
+		if err := rows.Scan(&con.Table, &con.Name, &con.Type, &con.Def); err != nil {
+			return nil, fmt.Errorf("failed to list constraints: %w", err)
+		}
+		s.Constraints = append(s.Constraints, con)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list constraints: %w", err)
+	}
+
+	// Indexes backing primary key, unique and exclusion constraints are
+	// recreated by the constraints themselves.
+	rows, _ = tx.Query(ctx, `
+SELECT pg_catalog.pg_get_indexdef(i.indexrelid)
+  FROM pg_catalog.pg_index i
+  JOIN pg_catalog.pg_class t ON t.oid = i.indrelid
+  JOIN pg_catalog.pg_class ic ON ic.oid = i.indexrelid
+  JOIN pg_catalog.pg_namespace n ON n.oid = t.relnamespace
+ WHERE n.nspname = 'public' AND t.relkind = 'r'
+   AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_constraint con
+                    WHERE con.conindid = i.indexrelid AND con.contype IN ('p', 'u', 'x'))
+ ORDER BY ic.relname`)
+	s.Indexes, err = pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexes: %w", err)
+	}
+
+	// Creation order (oid) keeps views that select from other views valid.
+	rows, _ = tx.Query(ctx, `
+SELECT c.relname, pg_catalog.pg_get_viewdef(c.oid)
+  FROM pg_catalog.pg_class c
+  JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+ WHERE n.nspname = 'public' AND c.relkind = 'v'
+ ORDER BY c.oid`)
+	s.Views, err = pgx.CollectRows(rows, pgx.RowToStructByPos[pgView])
+	if err != nil {
+		return nil, fmt.Errorf("failed to list views: %w", err)
+	}
+
+	return s, nil
+}
+
+// scanDumpScript reads a plain SQL dump and hands it on in SQL batches and
+// COPY data blocks, in order. COPY data is streamed to copyData as it is
+// read rather than held in memory. psql meta-commands (lines starting with
+// a backslash, such as \restrict in recent pg_dump output) are dropped
+// since only psql can run them.
+func scanDumpScript(r io.Reader, runSQL func(sql string) error, copyData func(stmt string, data io.Reader) error) error {
+	var sql strings.Builder
+	flush := func() error {
+		defer sql.Reset()
+		if strings.TrimSpace(sql.String()) == "" {
+			return nil
+		}
+		return runSQL(sql.String())
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<30)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, `\`):
+			continue
+		case strings.HasPrefix(trimmed, "COPY ") && strings.HasSuffix(trimmed, "FROM stdin;"):
+			if err := flush(); err != nil {
+				return err
+			}
+			if err := scanCopyData(scanner, strings.TrimSuffix(trimmed, ";"), copyData); err != nil {
+				return err
+			}
+		default:
+			sql.WriteString(line)
+			sql.WriteByte('\n')
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read dump: %w", err)
+	}
+	return flush()
+}
+
+// scanCopyData streams the data lines of one COPY block, up to the \.
+// terminator, to copyData.
+func scanCopyData(scanner *bufio.Scanner, stmt string, copyData func(stmt string, data io.Reader) error) error {
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := copyData(stmt, pr)
+		// Unblock the writer if copyData stopped reading early.
+		pr.CloseWithError(errCopyAborted)
+		done <- err
+	}()
+
+	err := copyLines(scanner, pw, stmt)
+	pw.CloseWithError(err)
+	if copyErr := <-done; copyErr != nil {
+		return copyErr
+	}
+	return err
+}
+
+// errCopyAborted is seen by copyLines when the COPY it feeds has ended.
+var errCopyAborted = errors.New("copy aborted")
+
+// copyLines writes COPY data lines from scanner to w until the \.
+// terminator.
+func copyLines(scanner *bufio.Scanner, w io.Writer, stmt string) error {
+	bw := bufio.NewWriterSize(w, 64*1024)
+	for scanner.Scan() {
+		if scanner.Text() == `\.` {
+			return bw.Flush()
+		}
+		bw.Write(scanner.Bytes())
+		if err := bw.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read dump: %w", err)
+	}
+	return fmt.Errorf("unterminated COPY data for %q", stmt)
+}
+
+// restoreNative replaces the contents of dbName with a plain SQL dump in a
+// single transaction, so a failed restore leaves the database untouched.
+// The dump is streamed to the server as it is read.
+func (c *PostgresConfig) restoreNative(ctx context.Context, dbName string, script io.Reader) error {
+	conn, err := c.connect(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin restore transaction: %w", err)
+	}
+	defer tx.Rollback(context.Background())
+
+	pgConn := conn.PgConn()
+	if err := execSQL(ctx, pgConn, dropPublicObjectsSQL); err != nil {
+		return fmt.Errorf("failed to drop existing objects: %w", err)
+	}
+	err = scanDumpScript(script,
+		func(sql string) error {
+			if err := execSQL(ctx, pgConn, sql); err != nil {
+				return fmt.Errorf("native restore failed: %w", err)
+			}
+			return nil
+		},
+		func(stmt string, data io.Reader) error {
+			if _, err := pgConn.CopyFrom(ctx, data, stmt); err != nil {
+				return fmt.Errorf("native restore failed: %s: %w", stmt, err)
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit restore: %w", err)
+	}
+	return nil
+}
+
+// execSQL runs one or more statements with the simple query protocol.
+func execSQL(ctx context.Context, pgConn *pgconn.PgConn, sql string) error {
+	_, err := pgConn.Exec(ctx, sql).ReadAll()
+	return err
+}
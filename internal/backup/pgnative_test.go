@@ -0,0 +1,138 @@
+package backup
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func testSchema() *pgSchema {
+	return &pgSchema{
+		Sequences: []pgSequence{
+			{Name: "Series_Id_seq", Type: "integer", Start: 1, Increment: 1, Min: 1, Max: 2147483647, Cache: 1,
+				OwnerTable: "Series", OwnerColumn: "Id", LastValue: 42, IsCalled: true},
+			{Name: "Logs_Id_seq", Type: "bigint", Start: 1, Increment: 1, Min: 1, Max: 9223372036854775807, Cache: 1,
+				OwnerTable: "Logs", OwnerColumn: "Id", Identity: true, LastValue: 1},
+		},
+		Tables: []pgTable{
+			{Name: "Series", Columns: []pgColumn{
+				{Name: "Id", Type: "integer", NotNull: true, Default: `nextval('public."Series_Id_seq"'::regclass)`},
+				{Name: "Title", Type: "text"},
+				{Name: "SortTitle", Type: "text", Generated: `lower("Title")`},
+			}},
+			{Name: "Logs", Columns: []pgColumn{
+				{Name: "Id", Type: "bigint", NotNull: true, Identity: "d"},
+			}},
+		},
+		Constraints: []pgConstraint{{Table: "Series", Name: "PK_Series", Type: "p", Def: `PRIMARY KEY ("Id")`}},
+		Indexes:     []string{`CREATE INDEX "IX_Series_Title" ON public."Series" USING btree ("Title")`},
+	}
+}
+
+func TestNativeSchemaRender(t *testing.T) {
+	s := testSchema()
+	var out bytes.Buffer
+	if err := s.writePreData(&out); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.writePostData(&out); err != nil {
+		t.Fatal(err)
+	}
+	script := out.String()
+
+	for _, want := range []string{
+		`CREATE SEQUENCE "public"."Series_Id_seq" AS integer START WITH 1`,
+		`"Id" integer DEFAULT nextval('public."Series_Id_seq"'::regclass) NOT NULL`,
+		`"SortTitle" text GENERATED ALWAYS AS (lower("Title")) STORED`,
+		`"Id" bigint GENERATED BY DEFAULT AS IDENTITY (SEQUENCE NAME "public"."Logs_Id_seq" START WITH 1`,
+		`ALTER SEQUENCE "public"."Series_Id_seq" OWNED BY "public"."Series"."Id";`,
+		`SELECT pg_catalog.setval('"public"."Series_Id_seq"', 42, true);`,
+		`SELECT pg_catalog.setval('"public"."Logs_Id_seq"', 1, false);`,
+		"ALTER TABLE ONLY \"public\".\"Series\"\n    ADD CONSTRAINT \"PK_Series\" PRIMARY KEY (\"Id\");",
+		`CREATE INDEX "IX_Series_Title" ON public."Series" USING btree ("Title");`,
+	} {
+		if !strings.Contains(script, want) {
+			t.Errorf("script missing %q:\n%s", want, script)
+		}
+	}
+	if strings.Contains(script, `CREATE SEQUENCE "public"."Logs_Id_seq"`) {
+		t.Error("identity sequence created separately")
+	}
+	if !strings.HasSuffix(script, "-- PostgreSQL database dump complete\n--\n\n") {
+		t.Error("missing dump trailer")
+	}
+	if got := s.Tables[0].copyColumns(); got != ` ("Id", "Title")` {
+		t.Errorf("copyColumns = %q, want generated column excluded", got)
+	}
+}
+
+func TestScanDumpScript(t *testing.T) {
+	script := `\restrict abc123
+SET client_encoding = 'UTF8';
+CREATE TABLE public."Config" (
+    "Key" text
+);
+
+COPY public."Config" ("Key") FROM stdin;
+a\tb
+line\\nwith COPY public.x FROM stdin;
+\.
+
+CREATE INDEX "IX" ON public."Config" ("Key");
+\unrestrict abc123
+`
+	parts, err := scanParts(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parts) != 3 {
+		t.Fatalf("got %d parts: %+v", len(parts), parts)
+	}
+	if parts[0].data != "" || !strings.Contains(parts[0].sql, "CREATE TABLE") || strings.Contains(parts[0].sql, `\restrict`) {
+		t.Errorf("part 0 = %+v", parts[0])
+	}
+	if parts[1].sql != `COPY public."Config" ("Key") FROM stdin` {
+		t.Errorf("copy statement = %q", parts[1].sql)
+	}
+	if parts[1].data != "a\\tb\nline\\\\nwith COPY public.x FROM stdin;\n" {
+		t.Errorf("copy data = %q", parts[1].data)
+	}
+	if !strings.Contains(parts[2].sql, "CREATE INDEX") || strings.Contains(parts[2].sql, "unrestrict") {
+		t.Errorf("part 2 = %+v", parts[2])
+	}
+
+	if _, err := scanParts("COPY public.t FROM stdin;\n1\n"); err == nil {
+		t.Error("unterminated COPY accepted")
+	}
+
+	// A COPY that fails part way stops the scan with its error.
+	copyErr := errors.New("copy failed")
+	err = scanDumpScript(strings.NewReader(script), func(string) error { return nil }, func(string, io.Reader) error {
+		return copyErr
+	})
+	if !errors.Is(err, copyErr) {
+		t.Errorf("scan with failing COPY = %v, want %v", err, copyErr)
+	}
+}
+
+// scriptPart is a SQL batch or a COPY block seen by scanDumpScript.
+type scriptPart struct {
+	sql, data string
+}
+
+func scanParts(script string) ([]scriptPart, error) {
+	var parts []scriptPart
+	err := scanDumpScript(strings.NewReader(script),
+		func(sql string) error {
+			parts = append(parts, scriptPart{sql: sql})
+			return nil
+		},
+		func(stmt string, data io.Reader) error {
+			b, err := io.ReadAll(data)
+			parts = append(parts, scriptPart{sql: stmt, data: string(b)})
+			return err
+		})
+	return parts, err
+}
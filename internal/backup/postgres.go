@@ -2,14 +2,41 @@ package backup
 
 import (
 	"archive/zip"
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 	"io"
 	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 )
 
+// Dump formats for PostgresConfig.Format.
+const (
+	// DumpFormatPlain is a SQL script restored with psql (the default).
+	DumpFormatPlain = "plain"
+	// DumpFormatCustom is a compressed pg_dump archive restored with
+	// pg_restore, which tolerates server version skew and can restore in
+	// parallel.
+	DumpFormatCustom = "custom"
+)
+
+// Dump engines for PostgresConfig.Engine.
+const (
+	// EnginePgTools shells out to pg_dump, psql and pg_restore (the default).
+	EnginePgTools = "pgtools"
+	// EngineNative dumps and restores plain SQL over a direct connection,
+	// so no PostgreSQL client tools need to be installed.
+	EngineNative = "native"
+)
+
+// customDumpMagic starts every custom-format pg_dump archive.
+var customDumpMagic = []byte("PGDMP")
+
 // PostgresConfig holds Postgres connection details extracted from config.xml
 type PostgresConfig struct {
 	Host     string
@@ -18,6 +45,76 @@ type PostgresConfig struct {
 	Password string
 	MainDB   string
 	LogDB    string
+
+	// Format is the pg_dump format for new backups; empty means plain.
+	Format string
+	// Jobs is the number of parallel pg_restore jobs used for custom-format
+	// dumps; 0 or 1 restores serially.
+	Jobs int
+	// Engine selects how dumps are taken and plain dumps restored; empty
+	// means EnginePgTools.
+	Engine string
+
+	// dumpMainDB and dumpLogDB are the databases the dumps in a backup were
+	// taken from, once Retarget has pointed MainDB or LogDB elsewhere.
+	dumpMainDB, dumpLogDB string
+}
+
+// Retarget points c, parsed from a backup's config.xml, at another server or
+// other database names: non-empty fields of t replace those of c, and dumps
+// taken from the original databases are restored into the new ones.
+func (c *PostgresConfig) Retarget(t *PostgresConfig) {
+	if t.Host != "" {
+		c.Host = t.Host
+	}
+	if t.Port != "" {
+		c.Port = t.Port
+	}
+	if t.User != "" {
+		c.User = t.User
+	}
+	if t.Password != "" {
+		c.Password = t.Password
+	}
+	if t.MainDB != "" && t.MainDB != c.MainDB {
+		if c.dumpMainDB == "" {
+			c.dumpMainDB = c.MainDB
+		}
+		c.MainDB = t.MainDB
+	}
+	if t.LogDB != "" && t.LogDB != c.LogDB {
+		if c.dumpLogDB == "" {
+			c.dumpLogDB = c.LogDB
+		}
+		c.LogDB = t.LogDB
+	}
+}
+
+// Validate reports an error if Format or Engine is not supported, or if
+// they are combined in a way that cannot work.
+func (c *PostgresConfig) Validate() error {
+	switch c.Format {
+	case "", DumpFormatPlain, DumpFormatCustom:
+	default:
+		return fmt.Errorf("invalid postgres format %q (want %q or %q)", c.Format, DumpFormatPlain, DumpFormatCustom)
+	}
+	switch c.Engine {
+	case "", EnginePgTools:
+	case EngineNative:
+		if c.Format == DumpFormatCustom {
+			return fmt.Errorf("postgres engine %q only writes plain dumps", EngineNative)
+		}
+	default:
+		return fmt.Errorf("invalid postgres engine %q (want %q or %q)", c.Engine, EnginePgTools, EngineNative)
+	}
+	return nil
+}
+
+func (c *PostgresConfig) format() string {
+	if c.Format == "" {
+		return DumpFormatPlain
+	}
+	return c.Format
 }
 
 // ConfigXML represents the XML structure of config.xml
@@ -37,7 +134,12 @@ func ParsePostgresConfigFromZip(zipData []byte) (*PostgresConfig, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to open zip: %w", err)
 	}
+	return ParsePostgresConfig(reader)
+}
 
+// ParsePostgresConfig extracts Postgres connection details from config.xml in an opened backup zip.
+// Returns nil (and no error) when config.xml has no Postgres settings (SQLite mode).
+func ParsePostgresConfig(reader *zip.Reader) (*PostgresConfig, error) {
 	for _, file := range reader.File {
 		if file.Name == "config.xml" {
 			rc, err := file.Open()
@@ -77,8 +179,22 @@ func ParsePostgresConfigFromZip(zipData []byte) (*PostgresConfig, error) {
 
 // DumpDatabase runs pg_dump and returns the SQL dump as bytes
 func (c *PostgresConfig) DumpDatabase(dbName string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := c.DumpDatabaseTo(context.Background(), dbName, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DumpDatabaseTo runs pg_dump and streams the dump, in c.Format, to w.
+// If writing to w fails, pg_dump is killed rather than left blocked on a full pipe.
+// With the native engine the plain dump is generated over a direct connection instead.
+func (c *PostgresConfig) DumpDatabaseTo(ctx context.Context, dbName string, w io.Writer) error {
 	if dbName == "" {
-		return nil, fmt.Errorf("database name is empty")
+		return fmt.Errorf("database name is empty")
+	}
+	if c.Engine == EngineNative {
+		return c.dumpNative(ctx, dbName, w)
 	}
 
 	// Build connection string for pg_dump
@@ -89,98 +205,124 @@ func (c *PostgresConfig) DumpDatabase(dbName string) ([]byte, error) {
 		"-U", c.User,
 		"-d", dbName,
 		"--no-password", // We'll use PGPASSWORD env var
-		"--format=plain",
+		"--format=" + c.format(),
 		"--no-owner",
 		"--no-acl",
 	}
 
-	cmd := exec.Command("pg_dump", args...)
+	cmd := exec.CommandContext(ctx, "pg_dump", args...)
 	cmd.Env = append(cmd.Environ(), fmt.Sprintf("PGPASSWORD=%s", c.Password))
 
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
+	var stderr bytes.Buffer
 	cmd.Stderr = &stderr
 
-	if err := cmd.Run(); err != nil {
-		return nil, fmt.Errorf("pg_dump failed: %w - %s", err, stderr.String())
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("pg_dump failed: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("pg_dump failed: %w", err)
 	}
 
-	return stdout.Bytes(), nil
-}
-
-// DumpAllDatabases dumps both main and log databases
-func (c *PostgresConfig) DumpAllDatabases() (map[string][]byte, error) {
-	dumps := make(map[string][]byte)
-
-	databases := []string{c.MainDB, c.LogDB}
-	for _, db := range databases {
-		if db == "" {
-			continue
-		}
+	_, copyErr := io.Copy(w, stdout)
+	if copyErr != nil {
+		cmd.Process.Kill()
+	}
+	waitErr := cmd.Wait()
 
-		dump, err := c.DumpDatabase(db)
-		if err != nil {
-			return nil, fmt.Errorf("failed to dump %s: %w", db, err)
-		}
+	if copyErr != nil {
+		return fmt.Errorf("failed to write pg_dump output: %w", copyErr)
+	}
+	if waitErr != nil {
+		return fmt.Errorf("pg_dump failed: %w - %s", waitErr, stderr.String())
+	}
+	return nil
+}
 
-		// Use sanitized filename
-		filename := strings.ReplaceAll(db, "-", "_") + ".sql"
-		dumps[filename] = dump
+// DumpFileName returns the sanitized file name used for a database's dump
+// inside the postgres/ directory of an enhanced backup: <db>.sql for plain
+// dumps and <db>.dump for custom-format ones.
+func DumpFileName(dbName, format string) string {
+	ext := ".sql"
+	if format == DumpFormatCustom {
+		ext = ".dump"
 	}
+	return strings.ReplaceAll(dbName, "-", "_") + ext
+}
 
-	return dumps, nil
+// isDumpFile reports whether an archive entry is a database dump written by
+// WriteEnhancedBackup.
+func isDumpFile(name string) bool {
+	return strings.HasPrefix(name, "postgres/") && (strings.HasSuffix(name, ".sql") || strings.HasSuffix(name, ".dump"))
 }
 
-// CreateEnhancedBackup takes the original backup zip data and adds pg_dump files
-func CreateEnhancedBackup(originalZip []byte, pgDumps map[string][]byte) ([]byte, error) {
-	var buf bytes.Buffer
-	zipWriter := zip.NewWriter(&buf)
+// WriteEnhancedBackup writes a copy of the original backup zip to w with a
+// pg_dump of each configured database appended under postgres/. Original
+// entries are copied without recompression and dumps are streamed straight
+// from pg_dump, so memory use does not grow with backup or database size.
+// Custom-format dumps are already compressed and are stored as-is.
+func WriteEnhancedBackup(ctx context.Context, w io.Writer, original *zip.Reader, pg *PostgresConfig) error {
+	zipWriter := zip.NewWriter(w)
 
 	// First, copy all files from the original zip
-	reader, err := zip.NewReader(bytes.NewReader(originalZip), int64(len(originalZip)))
-	if err != nil {
-		return nil, fmt.Errorf("failed to read original zip: %w", err)
+	for _, file := range original.File {
+		if err := zipWriter.Copy(file); err != nil {
+			return fmt.Errorf("failed to copy %s: %w", file.Name, err)
+		}
 	}
 
-	for _, file := range reader.File {
-		rc, err := file.Open()
-		if err != nil {
-			return nil, fmt.Errorf("failed to open %s: %w", file.Name, err)
-		}
+	// Add the pg_dump files
+	if err := pg.WriteDumps(ctx, zipWriter, []string{pg.MainDB, pg.LogDB}); err != nil {
+		return err
+	}
 
-		writer, err := zipWriter.CreateHeader(&file.FileHeader)
-		if err != nil {
-			rc.Close()
-			return nil, fmt.Errorf("failed to create %s: %w", file.Name, err)
-		}
+	if err := zipWriter.Close(); err != nil {
+		return fmt.Errorf("failed to close zip: %w", err)
+	}
+
+	return nil
+}
 
-		if _, err := io.Copy(writer, rc); err != nil {
-			rc.Close()
-			return nil, fmt.Errorf("failed to copy %s: %w", file.Name, err)
+// WriteDumps streams a dump of each named database into zipWriter under
+// postgres/, skipping empty names. Custom-format dumps are already
+// compressed and are stored as-is.
+func (c *PostgresConfig) WriteDumps(ctx context.Context, zipWriter *zip.Writer, dbs []string) error {
+	for _, db := range dbs {
+		if db == "" {
+			continue
 		}
-		rc.Close()
-	}
 
-	// Add the pg_dump files
-	for filename, data := range pgDumps {
-		writer, err := zipWriter.Create("postgres/" + filename)
+		filename := DumpFileName(db, c.format())
+		header := &zip.FileHeader{Name: "postgres/" + filename, Method: zip.Deflate}
+		if c.format() == DumpFormatCustom {
+			header.Method = zip.Store
+		}
+		writer, err := zipWriter.CreateHeader(header)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create %s: %w", filename, err)
+			return fmt.Errorf("failed to create %s: %w", filename, err)
 		}
 
-		if _, err := writer.Write(data); err != nil {
-			return nil, fmt.Errorf("failed to write %s: %w", filename, err)
+		if err := c.DumpDatabaseTo(ctx, db, writer); err != nil {
+			return fmt.Errorf("failed to dump %s: %w", db, err)
 		}
 	}
+	return nil
+}
 
-	if err := zipWriter.Close(); err != nil {
-		return nil, fmt.Errorf("failed to close zip: %w", err)
+// PostgresDumpFiles returns the postgres/*.sql and postgres/*.dump entries
+// of a backup zip, keyed by file name without the postgres/ prefix.
+func PostgresDumpFiles(reader *zip.Reader) map[string]*zip.File {
+	dumps := make(map[string]*zip.File)
+	for _, file := range reader.File {
+		if isDumpFile(file.Name) {
+			dumps[strings.TrimPrefix(file.Name, "postgres/")] = file
+		}
 	}
-
-	return buf.Bytes(), nil
+	return dumps
 }
 
-// ExtractPostgresDumpsFromZip extracts postgres/*.sql files from a backup zip
+// ExtractPostgresDumpsFromZip extracts the postgres/*.sql and postgres/*.dump
+// files from a backup zip
 func ExtractPostgresDumpsFromZip(zipData []byte) (map[string][]byte, error) {
 	reader, err := zip.NewReader(bytes.NewReader(zipData), int64(len(zipData)))
 	if err != nil {
@@ -188,42 +330,26 @@ func ExtractPostgresDumpsFromZip(zipData []byte) (map[string][]byte, error) {
 	}
 
 	dumps := make(map[string][]byte)
-	for _, file := range reader.File {
-		if strings.HasPrefix(file.Name, "postgres/") && strings.HasSuffix(file.Name, ".sql") {
-			rc, err := file.Open()
-			if err != nil {
-				return nil, fmt.Errorf("failed to open %s: %w", file.Name, err)
-			}
-
-			data, err := io.ReadAll(rc)
-			rc.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read %s: %w", file.Name, err)
-			}
+	for filename, file := range PostgresDumpFiles(reader) {
+		rc, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", file.Name, err)
+		}
 
-			// Extract just the filename without the postgres/ prefix
-			filename := strings.TrimPrefix(file.Name, "postgres/")
-			dumps[filename] = data
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file.Name, err)
 		}
+		dumps[filename] = data
 	}
 
 	return dumps, nil
 }
 
-// RestoreDatabase restores a database from a SQL dump using psql
-// It first drops all existing objects to ensure a clean restore
-func (c *PostgresConfig) RestoreDatabase(dbName string, sqlDump []byte) error {
-	if dbName == "" {
-		return fmt.Errorf("database name is empty")
-	}
-
-	// Filter out incompatible SET statements that may come from newer PostgreSQL versions
-	// e.g., transaction_timeout is only available in PostgreSQL 17+
-	filteredDump := filterIncompatibleStatements(sqlDump)
-
-	// First, drop all objects in the database to ensure a clean restore
-	// This drops all tables, views, functions, etc. in the public schema
-	dropSQL := `
+// dropPublicObjectsSQL drops all tables, sequences and views in the public
+// schema so a plain dump restores into an empty database.
+const dropPublicObjectsSQL = `
 DO $$ DECLARE
     r RECORD;
 BEGIN
@@ -242,6 +368,36 @@ BEGIN
 END $$;
 `
 
+// RestoreDatabase restores a database from a dump held in memory; see
+// RestoreDatabaseFrom.
+func (c *PostgresConfig) RestoreDatabase(dbName string, sqlDump []byte) error {
+	return c.RestoreDatabaseFrom(context.Background(), dbName, bytes.NewReader(sqlDump))
+}
+
+// RestoreDatabaseFrom restores a database from a dump read from r.
+// Custom-format dumps are restored with pg_restore --clean; plain SQL dumps
+// are piped through psql, or executed over a direct connection with the
+// native engine, after first dropping all existing objects to ensure a
+// clean restore. The dump is streamed, never held in memory in full.
+func (c *PostgresConfig) RestoreDatabaseFrom(ctx context.Context, dbName string, r io.Reader) error {
+	if dbName == "" {
+		return fmt.Errorf("database name is empty")
+	}
+
+	br := bufio.NewReader(r)
+	if head, _ := br.Peek(len(customDumpMagic)); bytes.Equal(head, customDumpMagic) {
+		return c.restoreCustomDump(ctx, dbName, br)
+	}
+
+	// Filter out incompatible SET statements that may come from newer PostgreSQL versions
+	// e.g., transaction_timeout is only available in PostgreSQL 17+
+	filteredDump := filterIncompatibleStatements(br)
+	defer filteredDump.Close()
+
+	if c.Engine == EngineNative {
+		return c.restoreNative(ctx, dbName, filteredDump)
+	}
+
 	// Build connection args for psql
 	baseArgs := []string{
 		"-h", c.Host,
@@ -251,8 +407,8 @@ END $$;
 		"--no-password", // We'll use PGPASSWORD env var
 	}
 
-	// First run the drop script
-	dropCmd := exec.Command("psql", append(baseArgs, "-c", dropSQL)...)
+	// First, drop all objects in the database to ensure a clean restore
+	dropCmd := exec.CommandContext(ctx, "psql", append(baseArgs, "-c", dropPublicObjectsSQL)...)
 	dropCmd.Env = append(dropCmd.Environ(), fmt.Sprintf("PGPASSWORD=%s", c.Password))
 	var dropStderr bytes.Buffer
 	dropCmd.Stderr = &dropStderr
@@ -263,9 +419,9 @@ END $$;
 
 	// Now run the restore
 	restoreArgs := append(baseArgs, "-v", "ON_ERROR_STOP=1")
-	restoreCmd := exec.Command("psql", restoreArgs...)
+	restoreCmd := exec.CommandContext(ctx, "psql", restoreArgs...)
 	restoreCmd.Env = append(restoreCmd.Environ(), fmt.Sprintf("PGPASSWORD=%s", c.Password))
-	restoreCmd.Stdin = bytes.NewReader(filteredDump)
+	restoreCmd.Stdin = filteredDump
 
 	var stderr bytes.Buffer
 	restoreCmd.Stderr = &stderr
@@ -277,61 +433,177 @@ END $$;
 	return nil
 }
 
-// filterIncompatibleStatements removes SET statements for parameters
-// that may not exist on older PostgreSQL versions
-func filterIncompatibleStatements(sqlDump []byte) []byte {
-	lines := strings.Split(string(sqlDump), "\n")
-	var filtered []string
+// restoreCustomDump restores a custom-format archive with pg_restore. The
+// archive is spooled to a temporary file because parallel restore needs a
+// seekable input.
+func (c *PostgresConfig) restoreCustomDump(ctx context.Context, dbName string, dump io.Reader) error {
+	spool, err := Spool(dump, "backuparr-restore-*.dump")
+	if err != nil {
+		return err
+	}
+	defer spool.Close()
+
+	args := []string{
+		"-h", c.Host,
+		"-p", c.Port,
+		"-U", c.User,
+		"-d", dbName,
+		"--no-password", // We'll use PGPASSWORD env var
+		"--clean",
+		"--if-exists",
+		"--no-owner",
+		"--no-acl",
+		"--exit-on-error",
+	}
+	if c.Jobs > 1 {
+		args = append(args, "--jobs", strconv.Itoa(c.Jobs))
+	}
+	args = append(args, spool.Name())
+
+	cmd := exec.CommandContext(ctx, "pg_restore", args...)
+	cmd.Env = append(cmd.Environ(), fmt.Sprintf("PGPASSWORD=%s", c.Password))
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("pg_restore failed: %w - %s", err, stderr.String())
+	}
+	return nil
+}
 
+// filterIncompatibleStatements removes SET statements for parameters
+// that may not exist on older PostgreSQL versions. The dump is filtered as
+// it is read.
+func filterIncompatibleStatements(sqlDump io.Reader) io.ReadCloser {
 	// Parameters that are version-specific and may cause errors
 	incompatibleParams := []string{
 		"transaction_timeout", // PostgreSQL 17+
 	}
 
-	for _, line := range lines {
-		skip := false
-		trimmed := strings.TrimSpace(line)
+	return StreamFrom(func(w io.Writer) error {
+		bw := bufio.NewWriterSize(w, 64*1024)
+		scanner := bufio.NewScanner(sqlDump)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1<<30)
+		for scanner.Scan() {
+			line := scanner.Text()
+			skip := false
+			trimmed := strings.TrimSpace(line)
+
+			// Check if this is a SET statement for an incompatible parameter
+			if strings.HasPrefix(strings.ToUpper(trimmed), "SET ") {
+				for _, param := range incompatibleParams {
+					if strings.Contains(strings.ToLower(trimmed), param) {
+						skip = true
+						break
+					}
+				}
+			}
 
-		// Check if this is a SET statement for an incompatible parameter
-		if strings.HasPrefix(strings.ToUpper(trimmed), "SET ") {
-			for _, param := range incompatibleParams {
-				if strings.Contains(strings.ToLower(trimmed), param) {
-					skip = true
-					break
+			if !skip {
+				bw.WriteString(line)
+				if err := bw.WriteByte('\n'); err != nil {
+					return err
 				}
 			}
 		}
+		if err := scanner.Err(); err != nil {
+			return fmt.Errorf("failed to read dump: %w", err)
+		}
+		return bw.Flush()
+	}, nil)
+}
+
+// targetDatabase maps a dump file name back to the database it restores into.
+// After Retarget, dumps that do not belong to a retargeted database are an
+// error rather than being restored into a name inferred from the file.
+func (c *PostgresConfig) targetDatabase(filename string) (string, error) {
+	for _, db := range []struct{ source, target string }{
+		{c.dumpMainDB, c.MainDB},
+		{c.dumpLogDB, c.LogDB},
+	} {
+		if db.source == "" {
+			db.source = db.target
+		}
+		if db.source == "" {
+			continue
+		}
+		if filename == DumpFileName(db.source, DumpFormatPlain) || filename == DumpFileName(db.source, DumpFormatCustom) {
+			return db.target, nil
+		}
+	}
+	if c.dumpMainDB != "" || c.dumpLogDB != "" {
+		return "", fmt.Errorf("dump %s does not belong to database %q or %q named in config.xml", filename, c.dumpMainDB, c.dumpLogDB)
+	}
+	// Try to infer database name from filename
+	name := strings.TrimSuffix(strings.TrimSuffix(filename, ".sql"), ".dump")
+	return strings.ReplaceAll(name, "_", "-"), nil
+}
 
-		if !skip {
-			filtered = append(filtered, line)
+// RestorePlan reports which databases RestoreAllDatabases would overwrite
+// for the given dump file names, without connecting to the server.
+func (c *PostgresConfig) RestorePlan(dumpFiles []string) (*PostgresRestorePlan, error) {
+	plan := &PostgresRestorePlan{Host: c.Host, Port: c.Port, User: c.User}
+	for _, f := range dumpFiles {
+		db, err := c.targetDatabase(f)
+		if err != nil {
+			return nil, err
 		}
+		plan.Databases = append(plan.Databases, db)
 	}
+	sort.Strings(plan.Databases)
+	return plan, nil
+}
 
-	return []byte(strings.Join(filtered, "\n"))
+// CheckRestoreTools fails if any of dumps is a custom-format archive and
+// pg_restore is not installed. The format of a backup is fixed when it is
+// taken, so a config switched to plain format or the native engine may
+// still be asked to restore an older custom-format backup. Call it before
+// restoring anything so a restore never stops half way through.
+func CheckRestoreTools(dumps map[string][]byte) error {
+	for filename, data := range dumps {
+		if bytes.HasPrefix(data, customDumpMagic) {
+			return requirePgRestore(filename)
+		}
+	}
+	return nil
+}
+
+// CheckRestoreToolsZip is CheckRestoreTools for dumps still inside a backup
+// zip. Only the start of each dump is read.
+func CheckRestoreToolsZip(dumps map[string]*zip.File) error {
+	for filename, file := range dumps {
+		rc, err := file.Open()
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", file.Name, err)
+		}
+		head := make([]byte, len(customDumpMagic))
+		n, _ := io.ReadFull(rc, head)
+		rc.Close()
+		if bytes.Equal(head[:n], customDumpMagic) {
+			return requirePgRestore(filename)
+		}
+	}
+	return nil
+}
+
+func requirePgRestore(filename string) error {
+	if _, err := exec.LookPath("pg_restore"); err != nil {
+		return fmt.Errorf("pg_restore is required to restore custom-format dump %s: %w", filename, err)
+	}
+	return nil
 }
 
 // RestoreAllDatabases restores databases from SQL dump files
 // The dumps map should have filenames like "main_db.sql" -> sql data
 func (c *PostgresConfig) RestoreAllDatabases(dumps map[string][]byte) error {
-	// Map sanitized filenames back to database names
-	dbMap := map[string]string{}
-	if c.MainDB != "" {
-		sanitized := strings.ReplaceAll(c.MainDB, "-", "_") + ".sql"
-		dbMap[sanitized] = c.MainDB
-	}
-	if c.LogDB != "" {
-		sanitized := strings.ReplaceAll(c.LogDB, "-", "_") + ".sql"
-		dbMap[sanitized] = c.LogDB
+	if err := CheckRestoreTools(dumps); err != nil {
+		return err
 	}
-
 	for filename, data := range dumps {
-		dbName, ok := dbMap[filename]
-		if !ok {
-			// Try to infer database name from filename
-			dbName = strings.TrimSuffix(filename, ".sql")
-			dbName = strings.ReplaceAll(dbName, "_", "-")
+		dbName, err := c.targetDatabase(filename)
+		if err != nil {
+			return err
 		}
-
 		if err := c.RestoreDatabase(dbName, data); err != nil {
 			return fmt.Errorf("failed to restore %s: %w", dbName, err)
 		}
@@ -339,3 +611,84 @@ func (c *PostgresConfig) RestoreAllDatabases(dumps map[string][]byte) error {
 
 	return nil
 }
+
+// RewriteConfigXML returns a copy of a backup zip whose config.xml points at
+// the server and databases set in pg; empty fields of pg leave the archived
+// values unchanged. Every other entry is copied as-is.
+func RewriteConfigXML(zipData []byte, pg *PostgresConfig) ([]byte, error) {
+	reader, err := zip.NewReader(bytes.NewReader(zipData), int64(len(zipData)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open zip: %w", err)
+	}
+
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	for _, file := range reader.File {
+		if file.Name != "config.xml" {
+			if err := zipWriter.Copy(file); err != nil {
+				return nil, fmt.Errorf("failed to copy %s: %w", file.Name, err)
+			}
+			continue
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open config.xml: %w", err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config.xml: %w", err)
+		}
+
+		for _, field := range []struct{ element, value string }{
+			{"PostgresHost", pg.Host},
+			{"PostgresPort", pg.Port},
+			{"PostgresUser", pg.User},
+			{"PostgresPassword", pg.Password},
+			{"PostgresMainDb", pg.MainDB},
+			{"PostgresLogDb", pg.LogDB},
+		} {
+			data = setConfigElement(data, field.element, field.value)
+		}
+
+		writer, err := zipWriter.CreateHeader(&zip.FileHeader{Name: file.Name, Method: zip.Deflate, Modified: file.Modified})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create config.xml: %w", err)
+		}
+		if _, err := writer.Write(data); err != nil {
+			return nil, fmt.Errorf("failed to write config.xml: %w", err)
+		}
+	}
+
+	if err := zipWriter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close zip: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// setConfigElement sets the text of a top-level config.xml element,
+// appending the element if it is missing. The rest of the document is left
+// byte-for-byte unchanged.
+func setConfigElement(doc []byte, element, value string) []byte {
+	if value == "" {
+		return doc
+	}
+	var escaped bytes.Buffer
+	xml.EscapeText(&escaped, []byte(value))
+	replacement := []byte("<" + element + ">" + escaped.String() + "</" + element + ">")
+
+	re := regexp.MustCompile(`<` + element + `>[^<]*</` + element + `>|<` + element + `\s*/>`)
+	if re.Match(doc) {
+		return re.ReplaceAllLiteral(doc, replacement)
+	}
+	end := bytes.LastIndex(doc, []byte("</Config>"))
+	if end < 0 {
+		return doc
+	}
+	out := append([]byte{}, doc[:end]...)
+	out = append(out, "  "...)
+	out = append(out, replacement...)
+	out = append(out, '\n')
+	return append(out, doc[end:]...)
+}
@@ -0,0 +1,218 @@
+package backup
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fakeTool installs a shell script named name on PATH that appends its
+// arguments to a log file and prints output. It returns the log path.
+func fakeTool(t *testing.T, name, output string) string {
+	t.Helper()
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, name+".log")
+	script := "#!/bin/sh\necho \"$@\" >> " + logPath + "\nprintf '%s' '" + output + "'\n"
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return logPath
+}
+
+func readLog(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestWriteEnhancedBackup_CustomFormat(t *testing.T) {
+	log := fakeTool(t, "pg_dump", "PGDMP-archive")
+
+	var orig bytes.Buffer
+	zw := zip.NewWriter(&orig)
+	fw, _ := zw.Create("config.xml")
+	fw.Write([]byte("<Config/>"))
+	zw.Close()
+	original, _ := zip.NewReader(bytes.NewReader(orig.Bytes()), int64(orig.Len()))
+
+	pg := &PostgresConfig{Host: "db", Port: "5432", User: "sonarr", MainDB: "sonarr-main", LogDB: "sonarr-log", Format: DumpFormatCustom}
+	var out bytes.Buffer
+	if err := WriteEnhancedBackup(context.Background(), &out, original, pg); err != nil {
+		t.Fatalf("WriteEnhancedBackup: %v", err)
+	}
+
+	if args := readLog(t, log); strings.Count(args, "--format=custom") != 2 {
+		t.Errorf("pg_dump args = %q, want --format=custom for both databases", args)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+		if strings.HasSuffix(f.Name, ".dump") && f.Method != zip.Store {
+			t.Errorf("%s is recompressed (method %d)", f.Name, f.Method)
+		}
+	}
+	if got := strings.Join(names, ","); got != "config.xml,postgres/sonarr_main.dump,postgres/sonarr_log.dump" {
+		t.Errorf("entries = %s", got)
+	}
+
+	dumps, err := ExtractPostgresDumpsFromZip(out.Bytes())
+	if err != nil || len(dumps) != 2 {
+		t.Fatalf("ExtractPostgresDumpsFromZip = %d dumps, %v", len(dumps), err)
+	}
+	if plan, err := pg.RestorePlan([]string{"sonarr_main.dump", "sonarr_log.dump"}); err != nil || strings.Join(plan.Databases, ",") != "sonarr-log,sonarr-main" {
+		t.Errorf("restore plan = %+v, %v", plan, err)
+	}
+}
+
+func TestRestoreDatabase_CustomFormat(t *testing.T) {
+	log := fakeTool(t, "pg_restore", "")
+
+	pg := &PostgresConfig{Host: "db", Port: "5432", User: "sonarr", Jobs: 4}
+	if err := pg.RestoreDatabase("sonarr-log", []byte("PGDMP\x01\x10")); err != nil {
+		t.Fatalf("RestoreDatabase: %v", err)
+	}
+
+	args := readLog(t, log)
+	for _, want := range []string{"-d sonarr-log", "--clean", "--if-exists", "--jobs 4"} {
+		if !strings.Contains(args, want) {
+			t.Errorf("pg_restore args %q missing %q", args, want)
+		}
+	}
+}
+
+func TestRestoreDatabaseFrom_Plain(t *testing.T) {
+	log := fakeTool(t, "psql", "")
+	stdin := filepath.Join(filepath.Dir(log), "stdin.sql")
+	script := "#!/bin/sh\necho \"$@\" >> " + log + "\ncat >> " + stdin + "\n"
+	if err := os.WriteFile(filepath.Join(filepath.Dir(log), "psql"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	pg := &PostgresConfig{Host: "db", Port: "5432", User: "sonarr"}
+	dump := "SET transaction_timeout = 0;\nSET client_encoding = 'UTF8';\nCREATE TABLE t (id int);\n"
+	if err := pg.RestoreDatabaseFrom(context.Background(), "sonarr-main", strings.NewReader(dump)); err != nil {
+		t.Fatalf("RestoreDatabaseFrom: %v", err)
+	}
+
+	got, err := os.ReadFile(stdin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "SET client_encoding = 'UTF8';\nCREATE TABLE t (id int);\n"; !strings.HasSuffix(string(got), want) || strings.Contains(string(got), "transaction_timeout") {
+		t.Errorf("psql stdin = %q, want the dump without transaction_timeout", got)
+	}
+}
+
+func TestRestoreAllDatabases_MissingPgRestore(t *testing.T) {
+	log := fakeTool(t, "psql", "")
+	// Only the fake psql is on PATH.
+	t.Setenv("PATH", filepath.Dir(log))
+
+	pg := &PostgresConfig{Host: "db", Port: "5432", User: "sonarr", MainDB: "sonarr-main", LogDB: "sonarr-log"}
+	err := pg.RestoreAllDatabases(map[string][]byte{
+		"sonarr_main.sql": []byte("SELECT 1;"),
+		"sonarr_log.dump": []byte("PGDMP\x01\x10"),
+	})
+	if err == nil || !strings.Contains(err.Error(), "pg_restore") {
+		t.Fatalf("RestoreAllDatabases error = %v, want pg_restore missing", err)
+	}
+	if _, err := os.Stat(log); err == nil {
+		t.Error("psql ran before the missing pg_restore was detected")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, format := range []string{"", DumpFormatPlain, DumpFormatCustom} {
+		if err := (&PostgresConfig{Format: format}).Validate(); err != nil {
+			t.Errorf("format %q: %v", format, err)
+		}
+	}
+	if err := (&PostgresConfig{Format: DumpFormatPlain, Engine: EngineNative}).Validate(); err != nil {
+		t.Errorf("native plain: %v", err)
+	}
+	for _, pg := range []PostgresConfig{
+		{Format: "directory"},
+		{Engine: "libpq"},
+		{Format: DumpFormatCustom, Engine: EngineNative},
+	} {
+		if err := pg.Validate(); err == nil {
+			t.Errorf("%+v accepted", pg)
+		}
+	}
+}
+
+func TestRetarget(t *testing.T) {
+	pg := &PostgresConfig{Host: "postgres", Port: "5432", User: "sonarr", MainDB: "sonarr-main", LogDB: "sonarr-log"}
+	pg.Retarget(&PostgresConfig{Host: "staging-db", MainDB: "sonarr-main-staging", LogDB: "sonarr-log-staging"})
+
+	plan, err := pg.RestorePlan([]string{"sonarr_main.sql", "sonarr_log.dump"})
+	if err != nil {
+		t.Fatalf("RestorePlan: %v", err)
+	}
+	if plan.Host != "staging-db" || plan.Port != "5432" || strings.Join(plan.Databases, ",") != "sonarr-log-staging,sonarr-main-staging" {
+		t.Errorf("plan = %+v", plan)
+	}
+
+	// A dump that does not belong to either archived database must not be
+	// restored under a name guessed from its file name.
+	if _, err := pg.RestorePlan([]string{"other_db.sql"}); err == nil {
+		t.Error("unmatched dump accepted after retarget")
+	}
+}
+
+func TestRewriteConfigXML(t *testing.T) {
+	config := "<Config>\n  <ApiKey>k</ApiKey>\n  <PostgresHost>postgres</PostgresHost>\n" +
+		"  <PostgresMainDb>sonarr-main</PostgresMainDb>\n  <PostgresLogDb />\n</Config>\n"
+	var orig bytes.Buffer
+	zw := zip.NewWriter(&orig)
+	for _, f := range []struct{ name, content string }{
+		{"config.xml", config},
+		{"postgres/sonarr_main.sql", "-- dump"},
+	} {
+		fw, _ := zw.Create(f.name)
+		fw.Write([]byte(f.content))
+	}
+	zw.Close()
+
+	out, err := RewriteConfigXML(orig.Bytes(), &PostgresConfig{Port: "5433", MainDB: "sonarr-main-staging", LogDB: "a&b"})
+	if err != nil {
+		t.Fatalf("RewriteConfigXML: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(out), int64(len(out)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zr.File) != 2 || zr.File[1].Name != "postgres/sonarr_main.sql" {
+		t.Fatalf("entries = %v", zr.File)
+	}
+	rc, _ := zr.File[0].Open()
+	data, _ := io.ReadAll(rc)
+	rc.Close()
+
+	want := "<Config>\n  <ApiKey>k</ApiKey>\n  <PostgresHost>postgres</PostgresHost>\n" +
+		"  <PostgresMainDb>sonarr-main-staging</PostgresMainDb>\n  <PostgresLogDb>a&amp;b</PostgresLogDb>\n" +
+		"  <PostgresPort>5433</PostgresPort>\n</Config>\n"
+	if string(data) != want {
+		t.Errorf("config.xml =\n%s\nwant\n%s", data, want)
+	}
+
+	pg, err := ParsePostgresConfig(zr)
+	if err != nil || pg.MainDB != "sonarr-main-staging" || pg.LogDB != "a&b" {
+		t.Errorf("parsed = %+v, %v", pg, err)
+	}
+}
@@ -1,7 +1,6 @@
 package backup
 
 import (
-	"bytes"
 	"io"
 	"log"
 	"net/http"
@@ -11,7 +10,11 @@ import (
 
 // RetryTransport wraps an http.RoundTripper with automatic retry logic
 // for transient failures (connection resets, EOF, timeouts, 5xx responses).
-// It buffers request bodies so they can be replayed on retries.
+//
+// Request bodies are never buffered. Bodies are replayed on retries via
+// req.GetBody, which http.NewRequest sets for in-memory bodies
+// (bytes.Buffer, bytes.Reader, strings.Reader). Streaming bodies without
+// GetBody (e.g. an io.Pipe) are sent exactly once.
 type RetryTransport struct {
 	// Base is the underlying transport to use. If nil, http.DefaultTransport is used.
 	Base http.RoundTripper
@@ -37,15 +40,11 @@ func NewRetryTransport(base http.RoundTripper) *RetryTransport {
 
 // RoundTrip executes the request with automatic retries on transient failures.
 func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	// Buffer the request body so we can replay it on retries.
-	var bodyBytes []byte
-	if req.Body != nil && req.Body != http.NoBody {
-		var err error
-		bodyBytes, err = io.ReadAll(req.Body)
-		req.Body.Close()
-		if err != nil {
-			return nil, err
-		}
+	hasBody := req.Body != nil && req.Body != http.NoBody
+
+	// A streaming body can only be read once, so it cannot be retried.
+	if hasBody && req.GetBody == nil {
+		return t.Base.RoundTrip(req)
 	}
 
 	maxRetries := t.MaxRetries
@@ -68,9 +67,16 @@ func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 
 		// Clone the request for each attempt to avoid modifying the original
 		attemptReq := req.Clone(req.Context())
-		if bodyBytes != nil {
-			attemptReq.Body = io.NopCloser(bytes.NewReader(bodyBytes))
-			attemptReq.ContentLength = int64(len(bodyBytes))
+		if hasBody {
+			if attempt == 0 {
+				attemptReq.Body = req.Body
+			} else {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				attemptReq.Body = body
+			}
 		} else {
 			attemptReq.Body = http.NoBody
 			attemptReq.ContentLength = 0
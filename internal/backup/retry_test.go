@@ -288,6 +288,34 @@ func TestRetryTransport_PreservesRequestBody(t *testing.T) {
 	}
 }
 
+func TestRetryTransport_StreamingBodyNotRetried(t *testing.T) {
+	mock := &mockTransport{
+		responses: []mockResponse{
+			{err: fmt.Errorf("connection reset by peer")},
+			{status: 200, body: "ok"},
+		},
+	}
+
+	rt := &RetryTransport{Base: mock, MaxRetries: 3, BaseDelay: 10 * time.Millisecond}
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("streamed"))
+		pw.Close()
+	}()
+	req, _ := http.NewRequest("POST", "http://example.com", pr)
+	if req.GetBody != nil {
+		t.Fatal("expected no GetBody for a pipe body")
+	}
+
+	_, err := rt.RoundTrip(req)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if mock.calls.Load() != 1 {
+		t.Errorf("expected 1 call (streaming bodies cannot be replayed), got %d", mock.calls.Load())
+	}
+}
+
 func TestRetryTransport_RetriesOn429(t *testing.T) {
 	mock := &mockTransport{
 		responses: []mockResponse{
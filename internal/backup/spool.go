@@ -0,0 +1,77 @@
+package backup
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// SpoolFile is a backup spooled to a temporary file on disk. It lets
+// clients that need random access to a backup (e.g. to read config.xml out
+// of a zip) work with multi-GB archives without holding them in memory.
+// Close removes the file.
+type SpoolFile struct {
+	*os.File
+	size int64
+}
+
+// Spool copies r into a new temporary file and rewinds it for reading.
+// The pattern is passed to os.CreateTemp; TMPDIR controls the location.
+func Spool(r io.Reader, pattern string) (*SpoolFile, error) {
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp file: %w", err)
+	}
+
+	n, err := io.Copy(f, r)
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, fmt.Errorf("failed to spool backup to %s: %w", f.Name(), err)
+	}
+
+	return &SpoolFile{File: f, size: n}, nil
+}
+
+// Size returns the number of bytes spooled.
+func (s *SpoolFile) Size() int64 { return s.size }
+
+// Close closes and removes the temporary file.
+func (s *SpoolFile) Close() error {
+	err := s.File.Close()
+	if rmErr := os.Remove(s.File.Name()); rmErr != nil && err == nil && !os.IsNotExist(rmErr) {
+		err = rmErr
+	}
+	return err
+}
+
+// pipeReadCloser is the read side of a producer goroutine. Closing it
+// aborts the producer and then releases any resources the producer was
+// reading from.
+type pipeReadCloser struct {
+	*io.PipeReader
+	cleanup func() error
+}
+
+func (p *pipeReadCloser) Close() error {
+	p.PipeReader.Close()
+	if p.cleanup != nil {
+		return p.cleanup()
+	}
+	return nil
+}
+
+// StreamFrom runs produce in a goroutine and returns a reader for what it
+// writes. Any error returned by produce is delivered to the reader. Closing
+// the reader stops the producer (its next write fails) and then calls
+// cleanup, if non-nil.
+func StreamFrom(produce func(w io.Writer) error, cleanup func() error) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(produce(pw))
+	}()
+	return &pipeReadCloser{PipeReader: pr, cleanup: cleanup}
+}
@@ -3,23 +3,160 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 // BackuparrConfig is the top-level configuration.
 type BackuparrConfig struct {
-	AppConfigs []AppConfig `yaml:"appConfigs"`
+	AppConfigs []AppConfig     `yaml:"appConfigs"`
+	Scheduler  SchedulerConfig `yaml:"scheduler,omitempty"`
+	Web        WebConfig       `yaml:"web,omitempty"`
+
+	Notifications []NotificationConfig `yaml:"notifications,omitempty"`
+}
+
+// Notification filters.
+const (
+	NotifyOnFailure = "failure"
+	NotifyAlways    = "always"
+)
+
+// NotificationConfig defines a target notified when a backup or restore job
+// finishes.
+type NotificationConfig struct {
+	Name string `yaml:"name,omitempty"` // optional display name; defaults to type
+	Type string `yaml:"type"`           // "webhook", "ntfy", "gotify", "discord", "slack", "smtp"
+	On   string `yaml:"on,omitempty"`   // "failure" (default) or "always"
+
+	// URL is the webhook URL (webhook, discord, slack), the topic URL
+	// (ntfy, e.g. https://ntfy.sh/backups) or the server URL (gotify).
+	URL string `yaml:"url,omitempty"`
+	// Token is the ntfy access token or the Gotify application token.
+	Token    string `yaml:"token,omitempty"`
+	Priority int    `yaml:"priority,omitempty"` // ntfy (1-5) and Gotify (0-10)
+
+	// Webhook only: extra request headers.
+	Headers map[string]string `yaml:"headers,omitempty"`
+	// Template is a Go text/template rendered with the job event. For
+	// webhooks it is the request body (default: the event as JSON); for
+	// every other type it replaces the message text.
+	Template string `yaml:"template,omitempty"`
+
+	// SMTP
+	Host     string   `yaml:"host,omitempty"`
+	Port     int      `yaml:"port,omitempty"` // default 587; 465 uses implicit TLS
+	Username string   `yaml:"username,omitempty"`
+	Password string   `yaml:"password,omitempty"`
+	From     string   `yaml:"from,omitempty"`
+	To       []string `yaml:"to,omitempty"`
+}
+
+// WebConfig configures the web UI and its API.
+type WebConfig struct {
+	Auth AuthConfig `yaml:"auth,omitempty"`
+	// AllowedOrigins lists extra origins (e.g. "https://backups.example.com")
+	// allowed to make state-changing and websocket requests. The UI's own
+	// origin is always allowed.
+	AllowedOrigins []string `yaml:"allowedOrigins,omitempty"`
+}
+
+// Web UI roles. Read-only users can list backups, jobs and verify results;
+// admins can also trigger backups, delete and restore.
+const (
+	RoleRead  = "read"
+	RoleAdmin = "admin"
+)
+
+// AuthConfig configures how web UI and API requests are authenticated. When
+// no method is configured every request is treated as an admin.
+type AuthConfig struct {
+	APIKeys     []APIKeyConfig     `yaml:"apiKeys,omitempty"`
+	Users       []UserConfig       `yaml:"users,omitempty"`
+	ForwardAuth *ForwardAuthConfig `yaml:"forwardAuth,omitempty"`
+	// AnonymousRole is granted to unauthenticated requests when auth is
+	// configured. Empty (the default) rejects them; "read" allows browsing.
+	AnonymousRole string `yaml:"anonymousRole,omitempty"`
+}
+
+// Enabled reports whether any authentication method is configured.
+func (a AuthConfig) Enabled() bool {
+	return len(a.APIKeys) > 0 || len(a.Users) > 0 || a.ForwardAuth != nil
+}
+
+// APIKeyConfig is a static key accepted in the X-Api-Key header.
+type APIKeyConfig struct {
+	Name string `yaml:"name,omitempty"` // shown in logs; defaults to "api-key"
+	Key  string `yaml:"key"`
+	Role string `yaml:"role,omitempty"` // "read" or "admin" (default)
+}
+
+// UserConfig is an HTTP basic auth user. PasswordHash is a bcrypt hash, as
+// printed by `backuparr hash-password`.
+type UserConfig struct {
+	Username     string `yaml:"username"`
+	PasswordHash string `yaml:"passwordHash"`
+	Role         string `yaml:"role,omitempty"` // "read" or "admin" (default)
+}
+
+// ForwardAuthConfig trusts the user identity set by a reverse proxy running an
+// auth server such as Authelia or Authentik. The headers are only honoured
+// on requests from TrustedProxies.
+type ForwardAuthConfig struct {
+	TrustedProxies []string `yaml:"trustedProxies"`         // IPs or CIDRs of the reverse proxy
+	UserHeader     string   `yaml:"userHeader,omitempty"`   // default "Remote-User"
+	GroupsHeader   string   `yaml:"groupsHeader,omitempty"` // default "Remote-Groups"
+	AdminUsers     []string `yaml:"adminUsers,omitempty"`
+	AdminGroups    []string `yaml:"adminGroups,omitempty"`
+	// DefaultRole is granted to authenticated users that are not admins.
+	// Defaults to "read".
+	DefaultRole string `yaml:"defaultRole,omitempty"`
+}
+
+// SchedulerConfig tunes the built-in scheduler used by `backuparr daemon`.
+type SchedulerConfig struct {
+	// Jitter is the maximum random delay added to each scheduled run
+	// (Go duration string, e.g. "5m"). Empty disables jitter.
+	Jitter string `yaml:"jitter,omitempty"`
+	// CatchUp runs an app immediately on startup when a scheduled run was
+	// missed while the daemon was down. Defaults to true.
+	CatchUp *bool `yaml:"catchUp,omitempty"`
+}
+
+// CatchUpEnabled reports whether missed-run catch-up is enabled.
+func (s SchedulerConfig) CatchUpEnabled() bool {
+	return s.CatchUp == nil || *s.CatchUp
+}
+
+// JitterDuration parses Jitter, returning zero when unset.
+func (s SchedulerConfig) JitterDuration() (time.Duration, error) {
+	if s.Jitter == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s.Jitter)
+	if err != nil {
+		return 0, fmt.Errorf("invalid scheduler jitter %q: %w", s.Jitter, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid scheduler jitter %q: must not be negative", s.Jitter)
+	}
+	return d, nil
 }
 
 // AppConfig configures a single application to back up.
 type AppConfig struct {
-	AppType    string            `yaml:"appType"`
-	Name       string            `yaml:"name,omitempty"` // optional display name; defaults to appType
-	Connection Connection        `yaml:"connection"`
-	Retention  RetentionPolicy   `yaml:"retention"`
-	Postgres   *PostgresOverride `yaml:"postgres,omitempty"`
-	Storage    []StorageConfig   `yaml:"storage,omitempty"`
+	AppType       string               `yaml:"appType"`
+	Name          string               `yaml:"name,omitempty"` // optional display name; defaults to appType
+	Connection    Connection           `yaml:"connection"`
+	Retention     RetentionPolicy      `yaml:"retention"`
+	Postgres      *PostgresOverride    `yaml:"postgres,omitempty"`
+	Files         *FilesConfig         `yaml:"files,omitempty"`         // appType files/sqlite only
+	Database      *DatabaseConfig      `yaml:"database,omitempty"`      // appType postgres/mysql only
+	Jellyfin      *JellyfinConfig      `yaml:"jellyfin,omitempty"`      // appType jellyfin/emby only
+	HomeAssistant *HomeAssistantConfig `yaml:"homeAssistant,omitempty"` // appType homeassistant only
+	Storage       []StorageConfig      `yaml:"storage,omitempty"`
+	Schedule      string               `yaml:"schedule,omitempty"` // cron expression used by `backuparr daemon`
 }
 
 type RetentionPolicy struct {
@@ -47,17 +184,82 @@ type PostgresOverride struct {
 	Password string `yaml:"password"`
 	MainDB   string `yaml:"mainDb"`
 	LogDB    string `yaml:"logDb"`
+
+	// Format is the pg_dump format: "plain" (default, restored with psql)
+	// or "custom" (compressed, restored with pg_restore).
+	Format string `yaml:"format,omitempty"`
+	// Jobs is the number of parallel pg_restore jobs for custom-format dumps.
+	Jobs int `yaml:"jobs,omitempty"`
+	// Engine is "pgtools" (default, pg_dump/psql/pg_restore) or "native"
+	// (built-in driver, plain format only, no client tools required).
+	Engine string `yaml:"engine,omitempty"`
+}
+
+// FilesConfig configures the files (alias sqlite) app type, which backs up a
+// directory mounted where backuparr runs instead of going through a sidecar.
+type FilesConfig struct {
+	Path    string   `yaml:"path"`
+	Exclude []string `yaml:"exclude,omitempty"` // glob patterns, e.g. "*.log", "cache/*"
+}
+
+// DatabaseConfig configures the postgres and mysql app types, which back up
+// named databases directly instead of through an application's API.
+type DatabaseConfig struct {
+	Host      string   `yaml:"host"`
+	Port      string   `yaml:"port,omitempty"`
+	User      string   `yaml:"user"`
+	Password  string   `yaml:"password"`
+	Databases []string `yaml:"databases"`
+
+	// Format, Jobs and Engine apply to postgres only; see PostgresOverride.
+	// Unlike there, Format defaults to "custom" unless Engine is "native".
+	Format string `yaml:"format,omitempty"`
+	Jobs   int    `yaml:"jobs,omitempty"`
+	Engine string `yaml:"engine,omitempty"`
+}
+
+// JellyfinConfig configures the jellyfin and emby app types. Jellyfin 10.11+
+// is backed up through its backup API, which writes archives into BackupPath;
+// older Jellyfin versions and Emby fall back to archiving DataPath.
+type JellyfinConfig struct {
+	// BackupPath is the server's backups directory (e.g. /config/data/backups
+	// in the Jellyfin container) as mounted where backuparr runs.
+	BackupPath string `yaml:"backupPath,omitempty"`
+	// DataPath is the server's data directory as mounted where backuparr
+	// runs. Cache, log, transcode and metadata directories are skipped.
+	DataPath string   `yaml:"dataPath,omitempty"`
+	Exclude  []string `yaml:"exclude,omitempty"` // extra glob patterns for DataPath
+
+	// Include optional items in backup API archives.
+	Metadata  bool `yaml:"metadata,omitempty"`
+	Subtitles bool `yaml:"subtitles,omitempty"`
+	Trickplay bool `yaml:"trickplay,omitempty"`
+}
+
+// HomeAssistantConfig configures the homeassistant app type. Backups always
+// include the Home Assistant configuration and database.
+type HomeAssistantConfig struct {
+	// Password encrypts new backups and decrypts protected ones on restore.
+	Password string `yaml:"password,omitempty"`
+	// Addons and Folders (e.g. "share", "media") are only available on
+	// Home Assistant OS and Supervised installs.
+	Addons  bool     `yaml:"addons,omitempty"`
+	Folders []string `yaml:"folders,omitempty"`
+	// InsecureSkipVerify accepts any certificate on an https URL, e.g. a
+	// self-signed one. Certificates are verified by default.
+	InsecureSkipVerify bool `yaml:"insecureSkipVerify,omitempty"`
 }
 
 // StorageConfig defines a storage backend destination.
 type StorageConfig struct {
 	Name string `yaml:"name,omitempty"` // optional display name; defaults to type
-	Type string `yaml:"type"`           // "local", "s3"
+	Type string `yaml:"type"`           // "local", "s3", "sftp", "webdav", "azure", "gcs", "restic", "pbs"
 
-	// Local backend
+	// Local backend, and the base directory on the server for SFTP and WebDAV
 	Path string `yaml:"path,omitempty"`
 
-	// S3 backend
+	// S3 backend. Bucket, Prefix, Endpoint and StorageClass also apply to
+	// GCS, and Prefix and Endpoint to Azure.
 	Bucket          string `yaml:"bucket,omitempty"`
 	Prefix          string `yaml:"prefix,omitempty"`
 	Region          string `yaml:"region,omitempty"`
@@ -65,6 +267,60 @@ type StorageConfig struct {
 	AccessKeyID     string `yaml:"accessKeyId,omitempty"`
 	SecretAccessKey string `yaml:"secretAccessKey,omitempty"`
 	StorageClass    string `yaml:"storageClass,omitempty"`
+
+	// SFTP backend. Password and/or PrivateKeyFile authenticate; the host
+	// key is checked against KnownHostsFile or the pinned HostKey.
+	Host                 string `yaml:"host,omitempty"`
+	Port                 int    `yaml:"port,omitempty"` // default 22
+	User                 string `yaml:"user,omitempty"`
+	Password             string `yaml:"password,omitempty"`
+	PrivateKeyFile       string `yaml:"privateKeyFile,omitempty"`
+	PrivateKeyPassphrase string `yaml:"privateKeyPassphrase,omitempty"`
+	KnownHostsFile       string `yaml:"knownHostsFile,omitempty"`
+	HostKey              string `yaml:"hostKey,omitempty"` // e.g. "ssh-ed25519 AAAA..."
+
+	// WebDAV backend, authenticated with User and Password.
+	URL string `yaml:"url,omitempty"`
+
+	// Azure Blob Storage backend. Credentials are a ConnectionString, or
+	// Account with an AccountKey or SASToken.
+	Container        string `yaml:"container,omitempty"`
+	AccessTier       string `yaml:"accessTier,omitempty"` // Hot, Cool, Cold, Archive
+	ConnectionString string `yaml:"connectionString,omitempty"`
+	Account          string `yaml:"account,omitempty"`
+	AccountKey       string `yaml:"accountKey,omitempty"`
+	SASToken         string `yaml:"sasToken,omitempty"`
+
+	// Google Cloud Storage backend. Without a service account key,
+	// application default credentials are used.
+	CredentialsFile string `yaml:"credentialsFile,omitempty"`
+	CredentialsJSON string `yaml:"credentialsJson,omitempty"`
+
+	// restic backend. The repository (a path or e.g. "s3:...", "sftp:...")
+	// must already be initialised; it is unlocked with Password or the
+	// contents of PasswordFile.
+	Repository   string `yaml:"repository,omitempty"`
+	PasswordFile string `yaml:"passwordFile,omitempty"`
+
+	// Proxmox Backup Server backend, reached at Host and Port (default
+	// 8007) as User (a user or API token) with Password (its secret).
+	Datastore   string `yaml:"datastore,omitempty"`
+	Namespace   string `yaml:"namespace,omitempty"`
+	Fingerprint string `yaml:"fingerprint,omitempty"` // server certificate SHA-256
+
+	// Encryption, when set, encrypts backups client-side before they are
+	// uploaded to this backend.
+	Encryption *EncryptionConfig `yaml:"encryption,omitempty"`
+}
+
+// EncryptionConfig configures client-side age encryption for a storage
+// backend. Exactly one of Passphrase or Recipients must be set for uploads.
+// Restores need the Passphrase, or an IdentityFile holding the private key
+// for one of the Recipients.
+type EncryptionConfig struct {
+	Passphrase   string   `yaml:"passphrase,omitempty"`
+	Recipients   []string `yaml:"recipients,omitempty"`   // age public keys (age1...)
+	IdentityFile string   `yaml:"identityFile,omitempty"` // age identity file used to decrypt
 }
 
 // Parse reads and parses the config file at the given path.
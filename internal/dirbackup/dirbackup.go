@@ -1,4 +1,8 @@
-package main
+// Package dirbackup backs up a directory tree to a ZIP archive and restores
+// it again. SQLite databases found in the tree are copied with the sqlite3
+// .backup command so the archive holds a consistent snapshot. It is shared
+// by the sidecar server and the local files client.
+package dirbackup
 
 import (
 	"archive/zip"
@@ -10,14 +14,30 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+
+	"backuparr/internal/backup"
 )
 
+// Dir is a directory tree that is backed up and restored as a ZIP archive.
+type Dir struct {
+	// Path is the root of the tree; archive entries are relative to it.
+	Path string
+	// Excludes are glob patterns for paths left out of backups.
+	Excludes []string
+	// LogPrefix tags log lines, e.g. "sidecar" or the app name.
+	LogPrefix string
+}
+
+func (d *Dir) logf(format string, args ...any) {
+	log.Printf("[%s] "+format, append([]any{d.LogPrefix}, args...)...)
+}
+
 // sqliteMagic is the first 16 bytes of every SQLite database file.
 var sqliteMagic = []byte("SQLite format 3\000")
 
-// isSQLiteFile checks whether the file at path is a SQLite database
+// IsSQLiteFile checks whether the file at path is a SQLite database
 // by reading its magic bytes header.
-func isSQLiteFile(path string) bool {
+func IsSQLiteFile(path string) bool {
 	f, err := os.Open(path)
 	if err != nil {
 		return false
@@ -36,7 +56,7 @@ func isSQLiteFile(path string) bool {
 // the sqlite3 .backup command. This ensures the copy is not corrupted
 // by in-progress writes or WAL transactions.
 // Falls back to a direct file copy if sqlite3 is not available.
-func safeCopySQLite(src, dst string) error {
+func (d *Dir) safeCopySQLite(src, dst string) error {
 	// Ensure destination directory exists
 	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
 		return fmt.Errorf("failed to create temp dir: %w", err)
@@ -50,14 +70,14 @@ func safeCopySQLite(src, dst string) error {
 
 		if err := cmd.Run(); err != nil {
 			// If sqlite3 backup fails, fall back to direct copy
-			log.Printf("[sidecar] Warning: sqlite3 .backup failed for %s (%v), falling back to direct copy", src, err)
+			d.logf("Warning: sqlite3 .backup failed for %s (%v), falling back to direct copy", src, err)
 			return directCopy(src, dst)
 		}
 		return nil
 	}
 
 	// sqlite3 not available — direct copy with warning
-	log.Printf("[sidecar] Warning: sqlite3 not found, copying %s directly (may be inconsistent if app is writing)", src)
+	d.logf("Warning: sqlite3 not found, copying %s directly (may be inconsistent if app is writing)", src)
 	return directCopy(src, dst)
 }
 
@@ -81,8 +101,8 @@ func directCopy(src, dst string) error {
 	return out.Close()
 }
 
-// shouldExclude returns true if relPath matches any of the glob patterns.
-func shouldExclude(relPath string, patterns []string) bool {
+// ShouldExclude returns true if relPath matches any of the glob patterns.
+func ShouldExclude(relPath string, patterns []string) bool {
 	for _, pattern := range patterns {
 		// Match against the full relative path
 		if matched, _ := filepath.Match(pattern, relPath); matched {
@@ -105,12 +125,12 @@ func shouldExclude(relPath string, patterns []string) bool {
 	return false
 }
 
-// createBackup creates a ZIP backup of backupPath, writing it to w.
+// Backup writes a ZIP backup of the tree to w.
 // SQLite databases are automatically detected and safely copied.
 // Auxiliary SQLite files (-wal, -journal, -shm) are excluded since
 // the .backup command produces a self-contained copy.
-func createBackup(backupPath string, excludes []string, w io.Writer) (*backupStats, error) {
-	backupPath = filepath.Clean(backupPath)
+func (d *Dir) Backup(w io.Writer) (*BackupStats, error) {
+	backupPath := filepath.Clean(d.Path)
 
 	// First pass: find all SQLite files so we can identify their auxiliary files
 	sqliteFiles := map[string]bool{} // absolute paths of detected SQLite DBs
@@ -121,7 +141,7 @@ func createBackup(backupPath string, excludes []string, w io.Writer) (*backupSta
 		if info.IsDir() {
 			return nil
 		}
-		if isSQLiteFile(path) {
+		if IsSQLiteFile(path) {
 			sqliteFiles[path] = true
 		}
 		return nil
@@ -139,7 +159,7 @@ func createBackup(backupPath string, excludes []string, w io.Writer) (*backupSta
 	}
 
 	// Create temp directory for SQLite safe copies
-	tempDir, err := os.MkdirTemp("", "sidecar-backup-*")
+	tempDir, err := os.MkdirTemp("", "backuparr-dir-*")
 	if err != nil {
 		return nil, fmt.Errorf("failed to create temp dir: %w", err)
 	}
@@ -149,14 +169,14 @@ func createBackup(backupPath string, excludes []string, w io.Writer) (*backupSta
 	for sqlPath := range sqliteFiles {
 		relPath, _ := filepath.Rel(backupPath, sqlPath)
 		tempPath := filepath.Join(tempDir, relPath)
-		if err := safeCopySQLite(sqlPath, tempPath); err != nil {
+		if err := d.safeCopySQLite(sqlPath, tempPath); err != nil {
 			return nil, fmt.Errorf("failed to safe-copy SQLite %s: %w", relPath, err)
 		}
-		log.Printf("[sidecar] SQLite detected and safely copied: %s", relPath)
+		d.logf("SQLite detected and safely copied: %s", relPath)
 	}
 
 	// Second pass: build the ZIP
-	stats := &backupStats{}
+	stats := &BackupStats{}
 	zw := zip.NewWriter(w)
 
 	err = filepath.Walk(backupPath, func(path string, info os.FileInfo, err error) error {
@@ -170,7 +190,7 @@ func createBackup(backupPath string, excludes []string, w io.Writer) (*backupSta
 		}
 
 		// Skip excluded paths
-		if shouldExclude(relPath, excludes) {
+		if ShouldExclude(relPath, d.Excludes) {
 			if info.IsDir() {
 				return filepath.SkipDir
 			}
@@ -240,9 +260,120 @@ func createBackup(backupPath string, excludes []string, w io.Writer) (*backupSta
 	return stats, nil
 }
 
-// backupStats holds metadata about a completed backup.
-type backupStats struct {
+// BackupStats holds metadata about a completed backup.
+type BackupStats struct {
 	TotalFiles  int
 	SQLiteFiles int
 	TotalBytes  int64
 }
+
+// Restore extracts a ZIP archive into the tree, overwriting existing files.
+// Directory structure is preserved. File permissions from the ZIP are restored.
+// The archive is read through an io.ReaderAt (e.g. an uploaded file spooled
+// to disk) rather than held in memory.
+func (d *Dir) Restore(r io.ReaderAt, size int64) (*RestoreStats, error) {
+	backupPath := filepath.Clean(d.Path)
+
+	reader, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open zip: %w", err)
+	}
+
+	stats := &RestoreStats{}
+
+	for _, file := range reader.File {
+		destPath, ok := safeDestPath(backupPath, file.Name)
+		if !ok {
+			d.logf("Warning: skipping potentially unsafe path: %s", file.Name)
+			continue
+		}
+
+		if file.FileInfo().IsDir() {
+			if err := os.MkdirAll(destPath, file.Mode()); err != nil {
+				return nil, fmt.Errorf("failed to create directory %s: %w", file.Name, err)
+			}
+			continue
+		}
+
+		// Ensure parent directory exists
+		if err := os.MkdirAll(filepath.Dir(destPath), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create parent dir for %s: %w", file.Name, err)
+		}
+
+		if err := extractFile(file, destPath); err != nil {
+			return nil, fmt.Errorf("failed to extract %s: %w", file.Name, err)
+		}
+
+		stats.FilesRestored++
+		stats.BytesRestored += int64(file.UncompressedSize64)
+	}
+
+	return stats, nil
+}
+
+// safeDestPath resolves an archive entry name under backupPath (which must
+// already be cleaned). It returns false for entries that would escape
+// backupPath (zip slip).
+func safeDestPath(backupPath, name string) (string, bool) {
+	destPath := filepath.Clean(filepath.Join(backupPath, name))
+	if !strings.HasPrefix(destPath, backupPath+string(os.PathSeparator)) && destPath != backupPath {
+		return "", false
+	}
+	return destPath, true
+}
+
+// PlanRestore reports the files Restore would write, without touching the
+// filesystem. Paths are relative to the root of the tree.
+func (d *Dir) PlanRestore(r io.ReaderAt, size int64) ([]backup.FileChange, error) {
+	backupPath := filepath.Clean(d.Path)
+
+	reader, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open zip: %w", err)
+	}
+
+	var files []backup.FileChange
+	for _, file := range reader.File {
+		destPath, ok := safeDestPath(backupPath, file.Name)
+		if !ok || file.FileInfo().IsDir() {
+			continue
+		}
+		action := backup.FileCreate
+		if _, err := os.Stat(destPath); err == nil {
+			action = backup.FileOverwrite
+		}
+		files = append(files, backup.FileChange{
+			Path:   file.Name,
+			Action: action,
+			Size:   int64(file.UncompressedSize64),
+		})
+	}
+	return files, nil
+}
+
+// extractFile extracts a single file from the ZIP to destPath.
+func extractFile(file *zip.File, destPath string) error {
+	rc, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	out, err := os.OpenFile(destPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, file.Mode())
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, rc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RestoreStats holds metadata about a completed restore.
+type RestoreStats struct {
+	FilesRestored int
+	BytesRestored int64
+}
@@ -0,0 +1,320 @@
+package dirbackup
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func restoreZip(dir string, data []byte) (*RestoreStats, error) {
+	return (&Dir{Path: dir}).Restore(bytes.NewReader(data), int64(len(data)))
+}
+
+// ---------------------------------------------------------------------------
+// SQLite detection
+// ---------------------------------------------------------------------------
+
+func TestIsSQLiteFile(t *testing.T) {
+	dir := t.TempDir()
+
+	// Create a file with SQLite magic bytes
+	sqliteFile := filepath.Join(dir, "test.db")
+	data := make([]byte, 100)
+	copy(data, []byte("SQLite format 3\000"))
+	os.WriteFile(sqliteFile, data, 0o644)
+
+	// A plain text file
+	textFile := filepath.Join(dir, "test.txt")
+	os.WriteFile(textFile, []byte("hello world"), 0o644)
+
+	// A file too small to have the magic header
+	tinyFile := filepath.Join(dir, "tiny")
+	os.WriteFile(tinyFile, []byte("hi"), 0o644)
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{sqliteFile, true},
+		{textFile, false},
+		{tinyFile, false},
+		{filepath.Join(dir, "nonexistent"), false},
+	}
+	for _, tt := range tests {
+		t.Run(filepath.Base(tt.path), func(t *testing.T) {
+			if got := IsSQLiteFile(tt.path); got != tt.want {
+				t.Errorf("IsSQLiteFile(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Exclude patterns
+// ---------------------------------------------------------------------------
+
+func TestShouldExclude(t *testing.T) {
+	tests := []struct {
+		relPath  string
+		patterns []string
+		want     bool
+	}{
+		{"debug.log", []string{"*.log"}, true},
+		{"config.xml", []string{"*.log"}, false},
+		{"cache/data.bin", []string{"cache/*"}, true},
+		{"cache", []string{"cache/*"}, true},
+		{"other/file", []string{"cache/*"}, false},
+		{"temp.log", []string{"*.tmp", "*.log"}, true},
+		{"keep.txt", []string{"*.tmp", "*.log"}, false},
+		{"sub/debug.log", []string{"*.log"}, true},
+		{"anything", nil, false},
+		{"anything", []string{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.relPath, func(t *testing.T) {
+			if got := ShouldExclude(tt.relPath, tt.patterns); got != tt.want {
+				t.Errorf("ShouldExclude(%q, %v) = %v, want %v", tt.relPath, tt.patterns, got, tt.want)
+			}
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Backup creation
+// ---------------------------------------------------------------------------
+
+func TestBackup(t *testing.T) {
+	dir := t.TempDir()
+
+	os.MkdirAll(filepath.Join(dir, "subdir"), 0o755)
+	os.WriteFile(filepath.Join(dir, "config.xml"), []byte("<config/>"), 0o644)
+	os.WriteFile(filepath.Join(dir, "subdir", "data.txt"), []byte("some data"), 0o644)
+	os.WriteFile(filepath.Join(dir, "app.log"), []byte("log entry"), 0o644)
+
+	// Fake SQLite file (magic header)
+	sqliteData := make([]byte, 100)
+	copy(sqliteData, []byte("SQLite format 3\000"))
+	os.WriteFile(filepath.Join(dir, "app.db"), sqliteData, 0o644)
+
+	// Auxiliary files that should be auto-skipped
+	os.WriteFile(filepath.Join(dir, "app.db-wal"), []byte("wal data"), 0o644)
+	os.WriteFile(filepath.Join(dir, "app.db-shm"), []byte("shm data"), 0o644)
+
+	var buf bytes.Buffer
+	stats, err := (&Dir{Path: dir, Excludes: []string{"*.log"}}).Backup(&buf)
+	if err != nil {
+		t.Fatalf("Backup: %v", err)
+	}
+
+	if stats.TotalFiles != 3 { // config.xml, data.txt, app.db
+		t.Errorf("TotalFiles = %d, want 3", stats.TotalFiles)
+	}
+	if stats.SQLiteFiles != 1 {
+		t.Errorf("SQLiteFiles = %d, want 1", stats.SQLiteFiles)
+	}
+
+	// Verify ZIP contents
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip.NewReader: %v", err)
+	}
+
+	entries := map[string]bool{}
+	for _, f := range zr.File {
+		entries[f.Name] = true
+	}
+
+	for _, want := range []string{"config.xml", "subdir/data.txt", "app.db"} {
+		if !entries[want] {
+			t.Errorf("ZIP missing expected entry: %s (have: %v)", want, entries)
+		}
+	}
+	for _, notWant := range []string{"app.log", "app.db-wal", "app.db-shm"} {
+		if entries[notWant] {
+			t.Errorf("ZIP should not contain: %s", notWant)
+		}
+	}
+}
+
+func TestBackup_EmptyDir(t *testing.T) {
+	var buf bytes.Buffer
+	stats, err := (&Dir{Path: t.TempDir()}).Backup(&buf)
+	if err != nil {
+		t.Fatalf("Backup: %v", err)
+	}
+	if stats.TotalFiles != 0 {
+		t.Errorf("TotalFiles = %d, want 0", stats.TotalFiles)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Restore
+// ---------------------------------------------------------------------------
+
+func TestRestore(t *testing.T) {
+	var zipBuf bytes.Buffer
+	zw := zip.NewWriter(&zipBuf)
+	w, _ := zw.Create("config.xml")
+	w.Write([]byte("<config>restored</config>"))
+	w, _ = zw.Create("subdir/data.txt")
+	w.Write([]byte("restored data"))
+	zw.Close()
+
+	destDir := t.TempDir()
+	stats, err := restoreZip(destDir, zipBuf.Bytes())
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if stats.FilesRestored != 2 {
+		t.Errorf("FilesRestored = %d, want 2", stats.FilesRestored)
+	}
+
+	data, err := os.ReadFile(filepath.Join(destDir, "config.xml"))
+	if err != nil {
+		t.Fatalf("ReadFile config.xml: %v", err)
+	}
+	if string(data) != "<config>restored</config>" {
+		t.Errorf("config.xml = %q", data)
+	}
+
+	data, err = os.ReadFile(filepath.Join(destDir, "subdir", "data.txt"))
+	if err != nil {
+		t.Fatalf("ReadFile subdir/data.txt: %v", err)
+	}
+	if string(data) != "restored data" {
+		t.Errorf("subdir/data.txt = %q", data)
+	}
+}
+
+func TestRestore_Overwrite(t *testing.T) {
+	destDir := t.TempDir()
+	os.WriteFile(filepath.Join(destDir, "config.xml"), []byte("old"), 0o644)
+
+	var zipBuf bytes.Buffer
+	zw := zip.NewWriter(&zipBuf)
+	w, _ := zw.Create("config.xml")
+	w.Write([]byte("new"))
+	zw.Close()
+
+	_, err := restoreZip(destDir, zipBuf.Bytes())
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	data, _ := os.ReadFile(filepath.Join(destDir, "config.xml"))
+	if string(data) != "new" {
+		t.Errorf("expected overwritten content, got %q", data)
+	}
+}
+
+func TestRestore_ZipSlip(t *testing.T) {
+	var zipBuf bytes.Buffer
+	zw := zip.NewWriter(&zipBuf)
+	hdr := &zip.FileHeader{Name: "../../../etc/passwd"}
+	w, _ := zw.CreateHeader(hdr)
+	w.Write([]byte("malicious"))
+	zw.Close()
+
+	destDir := t.TempDir()
+	stats, err := restoreZip(destDir, zipBuf.Bytes())
+	if err != nil {
+		t.Fatalf("should not error on zip slip (skips file): %v", err)
+	}
+	if stats.FilesRestored != 0 {
+		t.Errorf("FilesRestored = %d, want 0 (malicious entry should be skipped)", stats.FilesRestored)
+	}
+}
+
+func TestPlanRestore(t *testing.T) {
+	destDir := t.TempDir()
+	os.WriteFile(filepath.Join(destDir, "config.xml"), []byte("old"), 0o644)
+
+	var zipBuf bytes.Buffer
+	zw := zip.NewWriter(&zipBuf)
+	for _, name := range []string{"config.xml", "sonarr.db", "../../../etc/passwd"} {
+		w, _ := zw.Create(name)
+		w.Write([]byte("new"))
+	}
+	zw.Close()
+
+	files, err := (&Dir{Path: destDir}).PlanRestore(bytes.NewReader(zipBuf.Bytes()), int64(zipBuf.Len()))
+	if err != nil {
+		t.Fatalf("PlanRestore: %v", err)
+	}
+
+	want := map[string]string{"config.xml": "overwrite", "sonarr.db": "create"}
+	if len(files) != len(want) {
+		t.Fatalf("planned %d files, want %d: %+v", len(files), len(want), files)
+	}
+	for _, f := range files {
+		if want[f.Path] != f.Action || f.Size != 3 {
+			t.Errorf("planned %+v, want action %q size 3", f, want[f.Path])
+		}
+	}
+
+	// The plan must not touch the filesystem.
+	data, _ := os.ReadFile(filepath.Join(destDir, "config.xml"))
+	if string(data) != "old" {
+		t.Errorf("config.xml modified by dry run: %q", data)
+	}
+	if _, err := os.Stat(filepath.Join(destDir, "sonarr.db")); !os.IsNotExist(err) {
+		t.Error("sonarr.db created by dry run")
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Roundtrip (backup → restore)
+// ---------------------------------------------------------------------------
+
+func TestBackupRestore_Roundtrip(t *testing.T) {
+	srcDir := t.TempDir()
+	os.MkdirAll(filepath.Join(srcDir, "sub"), 0o755)
+	os.WriteFile(filepath.Join(srcDir, "a.txt"), []byte("file a"), 0o644)
+	os.WriteFile(filepath.Join(srcDir, "sub", "b.txt"), []byte("file b"), 0o644)
+
+	var buf bytes.Buffer
+	_, err := (&Dir{Path: srcDir}).Backup(&buf)
+	if err != nil {
+		t.Fatalf("Backup: %v", err)
+	}
+
+	dstDir := t.TempDir()
+	_, err = restoreZip(dstDir, buf.Bytes())
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	for _, rel := range []string{"a.txt", filepath.Join("sub", "b.txt")} {
+		orig, _ := os.ReadFile(filepath.Join(srcDir, rel))
+		restored, err := os.ReadFile(filepath.Join(dstDir, rel))
+		if err != nil {
+			t.Errorf("missing restored file %s: %v", rel, err)
+			continue
+		}
+		if !bytes.Equal(orig, restored) {
+			t.Errorf("content mismatch for %s", rel)
+		}
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Direct copy helper
+// ---------------------------------------------------------------------------
+
+func TestDirectCopy(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src.txt")
+	dst := filepath.Join(dir, "dst.txt")
+	os.WriteFile(src, []byte("copy me"), 0o644)
+
+	if err := directCopy(src, dst); err != nil {
+		t.Fatalf("directCopy: %v", err)
+	}
+
+	data, _ := os.ReadFile(dst)
+	if string(data) != "copy me" {
+		t.Errorf("got %q, want %q", data, "copy me")
+	}
+}
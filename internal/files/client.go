@@ -0,0 +1,122 @@
+// Package files implements a backup.Client for applications whose data
+// directory is mounted where backuparr runs (e.g. Bazarr or Jellyseerr
+// volumes on the same host). It archives the directory in-process with the
+// same SQLite-aware logic and ZIP layout as the sidecar, so no separate
+// sidecar container is needed.
+package files
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"backuparr/internal/backup"
+	"backuparr/internal/dirbackup"
+)
+
+// Verify Client satisfies the backup.Client interface at compile time.
+var _ backup.Client = (*Client)(nil)
+
+// Client backs up and restores a local directory.
+type Client struct {
+	appName string
+	dir     *dirbackup.Dir
+}
+
+// NewClient creates a client for the directory at path. Paths matching any
+// of the exclude glob patterns are left out of backups.
+func NewClient(appName, path string, excludes []string) (*Client, error) {
+	if appName == "" {
+		return nil, fmt.Errorf("app name is required for files client")
+	}
+	if path == "" {
+		return nil, fmt.Errorf("files path is required")
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("files path %q: %w", path, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("files path %q is not a directory", path)
+	}
+	return &Client{
+		appName: appName,
+		dir:     &dirbackup.Dir{Path: path, Excludes: excludes, LogPrefix: appName},
+	}, nil
+}
+
+// Name returns the configured application name.
+func (c *Client) Name() string {
+	return c.appName
+}
+
+// Backup archives the directory and returns the ZIP stream. The archive is
+// written through a pipe as it is read, so nothing is buffered in memory.
+func (c *Client) Backup(ctx context.Context) (*backup.BackupResult, io.ReadCloser, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		stats, err := c.dir.Backup(pw)
+		if err == nil {
+			log.Printf("[%s] Backup complete: %d files (%d SQLite), %d bytes",
+				c.appName, stats.TotalFiles, stats.SQLiteFiles, stats.TotalBytes)
+		}
+		pw.CloseWithError(err)
+	}()
+
+	result := &backup.BackupResult{
+		Name:      fmt.Sprintf("%s-files-backup", c.appName),
+		Path:      c.dir.Path,
+		CreatedAt: time.Now(),
+	}
+
+	log.Printf("[%s] Archiving %s", c.appName, c.dir.Path)
+	return result, pr, nil
+}
+
+// Restore extracts a backup ZIP into the directory, overwriting existing
+// files. The archive is spooled to a temporary file first so that it is
+// read completely (and checksum-verified by the caller) before anything is
+// written. With opts.DryRun only the files that would be created or
+// overwritten are reported.
+func (c *Client) Restore(ctx context.Context, backupData io.Reader, opts backup.RestoreOptions) (*backup.RestorePlan, error) {
+	f, err := os.CreateTemp("", "backuparr-files-*.zip")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	size, err := io.Copy(f, backupData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup data: %w", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	plan := &backup.RestorePlan{
+		App:   c.appName,
+		Notes: []string{fmt.Sprintf("restart %s after the restore so it picks up the restored files", c.appName)},
+	}
+	plan.Files, err = c.dir.PlanRestore(f, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup zip: %w", err)
+	}
+	if opts.DryRun {
+		return plan, nil
+	}
+
+	stats, err := c.dir.Restore(f, size)
+	if err != nil {
+		return nil, fmt.Errorf("restore failed: %w", err)
+	}
+	log.Printf("[%s] Restored %d files (%d bytes) into %s", c.appName, stats.FilesRestored, stats.BytesRestored, c.dir.Path)
+	return plan, nil
+}
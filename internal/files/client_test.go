@@ -0,0 +1,95 @@
+package files
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"backuparr/internal/backup"
+)
+
+func TestNewClient_Validation(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	os.WriteFile(file, nil, 0o644)
+
+	for name, path := range map[string]string{
+		"empty":         "",
+		"missing":       filepath.Join(t.TempDir(), "nope"),
+		"not directory": file,
+	} {
+		if _, err := NewClient("bazarr", path, nil); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestBackupRestore(t *testing.T) {
+	src := t.TempDir()
+	os.MkdirAll(filepath.Join(src, "db"), 0o755)
+	os.WriteFile(filepath.Join(src, "config.yaml"), []byte("general: {}"), 0o644)
+	os.WriteFile(filepath.Join(src, "bazarr.log"), []byte("noise"), 0o644)
+	sqliteData := make([]byte, 100)
+	copy(sqliteData, "SQLite format 3\000")
+	os.WriteFile(filepath.Join(src, "db", "bazarr.db"), sqliteData, 0o644)
+	os.WriteFile(filepath.Join(src, "db", "bazarr.db-wal"), []byte("wal"), 0o644)
+
+	client, err := NewClient("bazarr", src, []string{"*.log"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, reader, err := client.Backup(context.Background())
+	if err != nil {
+		t.Fatalf("Backup: %v", err)
+	}
+	data, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		t.Fatalf("read backup: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := map[string]bool{}
+	for _, f := range zr.File {
+		entries[f.Name] = true
+	}
+	if !entries["config.yaml"] || !entries["db/bazarr.db"] || entries["bazarr.log"] || entries["db/bazarr.db-wal"] {
+		t.Errorf("entries = %v", entries)
+	}
+
+	dst := t.TempDir()
+	os.WriteFile(filepath.Join(dst, "config.yaml"), []byte("old"), 0o644)
+	target, _ := NewClient("bazarr", dst, nil)
+
+	plan, err := target.Restore(context.Background(), bytes.NewReader(data), backup.RestoreOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	actions := map[string]string{}
+	for _, f := range plan.Files {
+		actions[f.Path] = f.Action
+	}
+	if actions["config.yaml"] != backup.FileOverwrite || actions["db/bazarr.db"] != backup.FileCreate {
+		t.Errorf("planned files = %+v", plan.Files)
+	}
+	if _, err := os.Stat(filepath.Join(dst, "db", "bazarr.db")); !os.IsNotExist(err) {
+		t.Fatal("dry run wrote files")
+	}
+
+	if _, err := target.Restore(context.Background(), bytes.NewReader(data), backup.RestoreOptions{}); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	restored, _ := os.ReadFile(filepath.Join(dst, "config.yaml"))
+	if string(restored) != "general: {}" {
+		t.Errorf("config.yaml = %q", restored)
+	}
+	if _, err := os.Stat(filepath.Join(dst, "db", "bazarr.db")); err != nil {
+		t.Errorf("database not restored: %v", err)
+	}
+}
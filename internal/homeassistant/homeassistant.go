@@ -0,0 +1,632 @@
+// Package homeassistant implements a backup client for Home Assistant using
+// the backup integration of Home Assistant Core (2025.1 and later). On
+// Home Assistant OS and Supervised installs Core hands the work to the
+// Supervisor, so the same API covers every installation type.
+//
+// Backup flow:
+//  1. Open a WebSocket to ws(s)://<host>/api/websocket and authenticate
+//     with a long-lived access token
+//  2. Pick the local backup agent from backup/agents/info
+//  3. Subscribe to backup/subscribe_events and call backup/generate
+//  4. Wait for the create_backup event to report completed or failed
+//  5. Look the backup up by name in backup/info
+//  6. HTTP GET /api/backup/download/<backup_id>?agent_id=<agent> to fetch
+//     the .tar
+//
+// Restore flow:
+//  1. HTTP POST multipart/form-data to /api/backup/upload?agent_id=<agent>
+//     with the .tar in the "file" field; the response holds the backup_id
+//  2. Call backup/restore over the WebSocket; Home Assistant restores the
+//     backup and restarts
+package homeassistant
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"backuparr/internal/backup"
+)
+
+// Verify Client satisfies the backup.Client interface at compile time.
+var _ backup.Client = (*Client)(nil)
+
+// Options selects what goes into a backup.
+type Options struct {
+	// Password encrypts new backups and decrypts protected ones on restore.
+	Password string
+	// Addons includes every installed add-on (Home Assistant OS and
+	// Supervised only).
+	Addons bool
+	// Folders lists extra folders to include, e.g. "share", "media", "ssl"
+	// (Home Assistant OS and Supervised only).
+	Folders []string
+	// InsecureSkipVerify skips TLS certificate verification, for servers
+	// with self-signed certificates.
+	InsecureSkipVerify bool
+}
+
+// Client implements backup.Client for Home Assistant.
+type Client struct {
+	appName string
+	baseURL string // e.g. "http://homeassistant.local:8123"
+	token   string // long-lived access token of an admin user
+	opts    Options
+}
+
+// NewClient creates a Home Assistant backup client.
+func NewClient(appName, baseURL, token string, opts Options) (*Client, error) {
+	if appName == "" {
+		return nil, fmt.Errorf("app name is required for homeassistant client")
+	}
+	if baseURL == "" {
+		return nil, fmt.Errorf("homeassistant url is required")
+	}
+	if token == "" {
+		return nil, fmt.Errorf("homeassistant access token is required (connection.apiKey)")
+	}
+	return &Client{
+		appName: appName,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+		opts:    opts,
+	}, nil
+}
+
+// Name returns the configured application name.
+func (c *Client) Name() string { return c.appName }
+
+func (c *Client) logf(format string, args ...any) {
+	log.Printf("[%s] "+format, append([]any{c.appName}, args...)...)
+}
+
+// backupInfo is the subset of a backup/info entry used here.
+type backupInfo struct {
+	BackupID string `json:"backup_id"`
+	Name     string `json:"name"`
+	Date     string `json:"date"`
+}
+
+// backupEvent is a backup/subscribe_events event.
+type backupEvent struct {
+	ManagerState string `json:"manager_state"`
+	Stage        string `json:"stage"`
+	State        string `json:"state"`
+	Reason       string `json:"reason"`
+}
+
+// Backup creates a backup in the local backup agent and streams its .tar.
+// The backup stays in Home Assistant's own backup list as well.
+func (c *Client) Backup(ctx context.Context) (*backup.BackupResult, io.ReadCloser, error) {
+	ws, err := c.dial(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer ws.close()
+	c.logf("Connected to Home Assistant %s", ws.version)
+
+	agent, err := c.localAgent(ws)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subID, err := ws.send(map[string]any{"type": "backup/subscribe_events"})
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := ws.result(subID, nil); err != nil {
+		return nil, nil, fmt.Errorf("backup/subscribe_events: %w", err)
+	}
+
+	name := fmt.Sprintf("backuparr %s", time.Now().UTC().Format("2006-01-02 15:04:05"))
+	generate := map[string]any{
+		"type":                  "backup/generate",
+		"agent_ids":             []string{agent},
+		"name":                  name,
+		"include_homeassistant": true,
+		"include_database":      true,
+		"include_all_addons":    c.opts.Addons,
+	}
+	if len(c.opts.Folders) > 0 {
+		generate["include_folders"] = c.opts.Folders
+	}
+	if c.opts.Password != "" {
+		generate["password"] = c.opts.Password
+	}
+	genID, err := ws.send(generate)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := ws.result(genID, nil); err != nil {
+		return nil, nil, fmt.Errorf("backup/generate: %w", err)
+	}
+	c.logf("Backup %q started, waiting for completion...", name)
+
+	if err := c.waitForBackup(ctx, ws, subID); err != nil {
+		return nil, nil, err
+	}
+
+	var info struct {
+		Backups []backupInfo `json:"backups"`
+	}
+	if err := ws.call(map[string]any{"type": "backup/info"}, &info); err != nil {
+		return nil, nil, fmt.Errorf("backup/info: %w", err)
+	}
+	var created *backupInfo
+	for i := range info.Backups {
+		if info.Backups[i].Name == name {
+			created = &info.Backups[i]
+		}
+	}
+	if created == nil {
+		return nil, nil, fmt.Errorf("backup %q not found after completion", name)
+	}
+
+	body, size, err := c.download(ctx, created.BackupID, agent)
+	if err != nil {
+		return nil, nil, fmt.Errorf("download: %w", err)
+	}
+	c.logf("Backup %s complete, downloading", created.BackupID)
+
+	createdAt := time.Now()
+	if t, err := time.Parse(time.RFC3339, created.Date); err == nil {
+		createdAt = t
+	}
+	return &backup.BackupResult{
+		Name:       created.BackupID + ".tar",
+		Size:       size,
+		CreatedAt:  createdAt,
+		AppVersion: ws.version,
+		DBType:     backup.DBTypeSQLite,
+	}, body, nil
+}
+
+// localAgent returns the ID of the backup agent that stores backups on the
+// Home Assistant host: backup.local on Core installs, hassio.local under
+// the Supervisor.
+func (c *Client) localAgent(ws *wsClient) (string, error) {
+	var info struct {
+		Agents []struct {
+			AgentID string `json:"agent_id"`
+		} `json:"agents"`
+	}
+	if err := ws.call(map[string]any{"type": "backup/agents/info"}, &info); err != nil {
+		return "", fmt.Errorf("backup/agents/info: %w", err)
+	}
+	for _, a := range info.Agents {
+		if strings.HasSuffix(a.AgentID, ".local") {
+			return a.AgentID, nil
+		}
+	}
+	return "", fmt.Errorf("no local backup agent found (Home Assistant 2025.1 or later is required)")
+}
+
+// waitForBackup reads subscription events until the backup manager reports
+// that the backup finished.
+func (c *Client) waitForBackup(ctx context.Context, ws *wsClient, subID int64) error {
+	var lastStage string
+	for {
+		raw, err := ws.event(subID)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if err != nil {
+			return fmt.Errorf("waiting for backup: %w", err)
+		}
+		var ev backupEvent
+		if err := json.Unmarshal(raw, &ev); err != nil {
+			return fmt.Errorf("decode backup event: %w", err)
+		}
+		if ev.ManagerState != "create_backup" {
+			continue
+		}
+		if ev.Stage != "" && ev.Stage != lastStage {
+			c.logf("Backup stage: %s", ev.Stage)
+			lastStage = ev.Stage
+		}
+		switch ev.State {
+		case "completed":
+			return nil
+		case "failed":
+			if ev.Reason != "" {
+				return fmt.Errorf("backup failed: %s", ev.Reason)
+			}
+			return fmt.Errorf("backup failed")
+		}
+	}
+}
+
+// archiveInfo is the subset of backup.json, the metadata file at the root
+// of every Home Assistant backup, used to plan a restore.
+type archiveInfo struct {
+	Slug          string `json:"slug"`
+	Name          string `json:"name"`
+	Protected     bool   `json:"protected"`
+	Homeassistant *struct {
+		Version string `json:"version"`
+	} `json:"homeassistant"`
+	Addons []struct {
+		Slug string `json:"slug"`
+	} `json:"addons"`
+	Folders []string `json:"folders"`
+}
+
+// Restore uploads a backup to the local backup agent and restores it. Home
+// Assistant restarts to apply the backup, so the caller should expect the
+// instance to be unavailable for a while after this returns.
+//
+// Add-ons and folders are restored when the backup contains them. With
+// opts.DryRun the archive is only inspected.
+func (c *Client) Restore(ctx context.Context, data io.Reader, opts backup.RestoreOptions) (*backup.RestorePlan, error) {
+	spool, err := backup.Spool(data, "backuparr-homeassistant-*.tar")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup data: %w", err)
+	}
+	defer spool.Close()
+
+	meta, err := readArchiveInfo(spool)
+	if err != nil {
+		return nil, err
+	}
+	if meta.Protected && c.opts.Password == "" {
+		return nil, fmt.Errorf("backup is password protected; set the homeassistant password")
+	}
+
+	plan := &backup.RestorePlan{
+		App:           c.appName,
+		DBType:        backup.DBTypeSQLite,
+		Restart:       true,
+		RestartMethod: "Home Assistant",
+		Notes:         []string{"the Home Assistant configuration and database of " + c.baseURL + " are replaced"},
+	}
+	addons := make([]string, 0, len(meta.Addons))
+	for _, a := range meta.Addons {
+		addons = append(addons, a.Slug)
+	}
+	if len(addons) > 0 {
+		plan.Notes = append(plan.Notes, "add-ons restored: "+strings.Join(addons, ", "))
+	}
+	if len(meta.Folders) > 0 {
+		plan.Notes = append(plan.Notes, "folders restored: "+strings.Join(meta.Folders, ", "))
+	}
+	if opts.DryRun {
+		return plan, nil
+	}
+
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to rewind backup: %w", err)
+	}
+
+	ws, err := c.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer ws.close()
+	agent, err := c.localAgent(ws)
+	if err != nil {
+		return nil, err
+	}
+
+	backupID, err := c.upload(ctx, agent, spool)
+	if err != nil {
+		return nil, fmt.Errorf("upload: %w", err)
+	}
+	c.logf("Uploaded backup %s, starting restore...", backupID)
+
+	restore := map[string]any{
+		"type":                  "backup/restore",
+		"backup_id":             backupID,
+		"agent_id":              agent,
+		"restore_database":      true,
+		"restore_homeassistant": true,
+		"restore_addons":        addons,
+		"restore_folders":       meta.Folders,
+	}
+	if c.opts.Password != "" {
+		restore["password"] = c.opts.Password
+	}
+	if err := ws.call(restore, nil); err != nil {
+		// Home Assistant may shut down before it answers; only a failed
+		// command means the restore did not start.
+		var cmdErr *commandError
+		if errors.As(err, &cmdErr) {
+			return nil, fmt.Errorf("backup/restore: %w", err)
+		}
+		c.logf("Connection closed while restoring (%v)", err)
+	}
+
+	c.logf("Restore started, Home Assistant is restarting")
+	return plan, nil
+}
+
+// readArchiveInfo reads backup.json from a Home Assistant backup tar.
+func readArchiveInfo(r io.Reader) (*archiveInfo, error) {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("backup.json not found: not a Home Assistant backup")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read backup tar: %w", err)
+		}
+		if strings.TrimPrefix(hdr.Name, "./") != "backup.json" {
+			continue
+		}
+		var info archiveInfo
+		if err := json.NewDecoder(tr).Decode(&info); err != nil {
+			return nil, fmt.Errorf("decode backup.json: %w", err)
+		}
+		return &info, nil
+	}
+}
+
+// httpClient returns a client for downloads and uploads, which can take a
+// long time for backups with add-ons and media folders.
+func (c *Client) httpClient() *http.Client {
+	transport := &http.Transport{TLSClientConfig: c.tlsConfig()}
+	return &http.Client{Transport: transport}
+}
+
+// tlsConfig returns the TLS configuration for https and wss connections, or
+// nil for the default one that verifies certificates.
+func (c *Client) tlsConfig() *tls.Config {
+	if !c.opts.InsecureSkipVerify {
+		return nil
+	}
+	return &tls.Config{InsecureSkipVerify: true} //nolint:gosec // opted in for self-signed certs
+}
+
+// download streams a backup from the agent.
+func (c *Client) download(ctx context.Context, backupID, agent string) (io.ReadCloser, int64, error) {
+	u := fmt.Sprintf("%s/api/backup/download/%s?agent_id=%s", c.baseURL, url.PathEscape(backupID), url.QueryEscape(agent))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("HTTP request: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		resp.Body.Close()
+		return nil, 0, fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return resp.Body, resp.ContentLength, nil
+}
+
+// upload streams a backup to the agent as multipart/form-data and returns
+// the backup ID Home Assistant assigned to it.
+func (c *Client) upload(ctx context.Context, agent string, file io.Reader) (string, error) {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		part, err := mw.CreateFormFile("file", "backup.tar")
+		if err == nil {
+			_, err = io.Copy(part, file)
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	u := fmt.Sprintf("%s/api/backup/upload?agent_id=%s", c.baseURL, url.QueryEscape(agent))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, pr)
+	if err != nil {
+		pr.Close()
+		return "", fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return "", fmt.Errorf("HTTP request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return "", fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	var result struct {
+		BackupID string `json:"backup_id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("decode response: %w", err)
+	}
+	if result.BackupID == "" {
+		return "", fmt.Errorf("upload response has no backup_id")
+	}
+	return result.BackupID, nil
+}
+
+// wsURL returns the WebSocket API URL derived from the base HTTP URL.
+func (c *Client) wsURL() (string, error) {
+	u, err := url.Parse(c.baseURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid url %q: %w", c.baseURL, err)
+	}
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	default:
+		u.Scheme = "ws"
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/api/websocket"
+	return u.String(), nil
+}
+
+// --- WebSocket API client ---
+
+// wsClient wraps a Home Assistant WebSocket API connection. Messages carry
+// an id chosen by the client; results and subscription events echo it.
+type wsClient struct {
+	conn    *websocket.Conn
+	stop    func() bool
+	version string
+	nextID  int64
+	// events buffers subscription events read while waiting for a result.
+	events []wsMessage
+}
+
+type wsMessage struct {
+	ID      int64           `json:"id"`
+	Type    string          `json:"type"`
+	Success bool            `json:"success"`
+	Result  json.RawMessage `json:"result"`
+	Event   json.RawMessage `json:"event"`
+	Error   *commandError   `json:"error"`
+
+	// Authentication phase.
+	HAVersion string `json:"ha_version"`
+	Message   string `json:"message"`
+}
+
+// commandError is the error of a failed command result.
+type commandError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *commandError) Error() string {
+	if e.Code == "" {
+		return e.Message
+	}
+	return e.Code + ": " + e.Message
+}
+
+// dial connects and authenticates. The connection is closed when ctx is
+// done, which unblocks pending reads.
+func (c *Client) dial(ctx context.Context) (*wsClient, error) {
+	wsURL, err := c.wsURL()
+	if err != nil {
+		return nil, err
+	}
+	dialer := websocket.Dialer{HandshakeTimeout: 10 * time.Second, TLSClientConfig: c.tlsConfig()}
+	conn, _, err := dialer.DialContext(ctx, wsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("websocket connect: dial %s: %w", wsURL, err)
+	}
+	// Closing the connection unblocks pending reads when ctx is done.
+	ws := &wsClient{conn: conn, stop: context.AfterFunc(ctx, func() { conn.Close() })}
+
+	conn.SetReadDeadline(time.Now().Add(30 * time.Second))
+	defer conn.SetReadDeadline(time.Time{})
+
+	var msg wsMessage
+	if err := conn.ReadJSON(&msg); err != nil {
+		ws.close()
+		return nil, fmt.Errorf("websocket connect: %w", err)
+	}
+	if msg.Type != "auth_required" {
+		ws.close()
+		return nil, fmt.Errorf("websocket connect: unexpected message %q", msg.Type)
+	}
+	if err := conn.WriteJSON(map[string]string{"type": "auth", "access_token": c.token}); err != nil {
+		ws.close()
+		return nil, fmt.Errorf("auth: %w", err)
+	}
+	msg = wsMessage{}
+	if err := conn.ReadJSON(&msg); err != nil {
+		ws.close()
+		return nil, fmt.Errorf("auth: %w", err)
+	}
+	if msg.Type != "auth_ok" {
+		ws.close()
+		return nil, fmt.Errorf("authentication failed: %s", msg.Message)
+	}
+	ws.version = msg.HAVersion
+	return ws, nil
+}
+
+func (ws *wsClient) close() error {
+	ws.stop()
+	return ws.conn.Close()
+}
+
+// send writes a command with the next message id and returns that id.
+func (ws *wsClient) send(cmd map[string]any) (int64, error) {
+	ws.nextID++
+	cmd["id"] = ws.nextID
+	if err := ws.conn.WriteJSON(cmd); err != nil {
+		return 0, fmt.Errorf("write %v: %w", cmd["type"], err)
+	}
+	return ws.nextID, nil
+}
+
+// call sends a command and decodes its result into result when non-nil.
+func (ws *wsClient) call(cmd map[string]any, result any) error {
+	id, err := ws.send(cmd)
+	if err != nil {
+		return err
+	}
+	return ws.result(id, result)
+}
+
+// result waits for the result message of command id, buffering any
+// subscription events that arrive first.
+func (ws *wsClient) result(id int64, result any) error {
+	ws.conn.SetReadDeadline(time.Now().Add(2 * time.Minute))
+	defer ws.conn.SetReadDeadline(time.Time{})
+
+	for {
+		var msg wsMessage
+		if err := ws.conn.ReadJSON(&msg); err != nil {
+			return err
+		}
+		if msg.Type == "event" {
+			ws.events = append(ws.events, msg)
+			continue
+		}
+		if msg.Type != "result" || msg.ID != id {
+			continue
+		}
+		if !msg.Success {
+			if msg.Error == nil {
+				return &commandError{Message: "command failed"}
+			}
+			return msg.Error
+		}
+		if result != nil && len(msg.Result) > 0 {
+			return json.Unmarshal(msg.Result, result)
+		}
+		return nil
+	}
+}
+
+// event returns the next event of subscription subID.
+func (ws *wsClient) event(subID int64) (json.RawMessage, error) {
+	for {
+		for len(ws.events) > 0 {
+			msg := ws.events[0]
+			ws.events = ws.events[1:]
+			if msg.ID == subID {
+				return msg.Event, nil
+			}
+		}
+		var msg wsMessage
+		if err := ws.conn.ReadJSON(&msg); err != nil {
+			return nil, err
+		}
+		if msg.Type == "event" {
+			ws.events = append(ws.events, msg)
+		}
+	}
+}
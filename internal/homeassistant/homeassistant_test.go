@@ -0,0 +1,247 @@
+package homeassistant
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+
+	"backuparr/internal/backup"
+)
+
+const testToken = "test-token"
+
+// mockHA emulates the Home Assistant WebSocket and backup HTTP endpoints.
+type mockHA struct {
+	server   *httptest.Server
+	upgrader websocket.Upgrader
+	archive  []byte
+
+	failBackup bool
+	backupName string
+	uploaded   []byte
+	restore    map[string]any
+}
+
+func newMockHA(t *testing.T, archive []byte) *mockHA {
+	m := &mockHA{
+		archive:  archive,
+		upgrader: websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/websocket", m.handleWebSocket)
+	mux.HandleFunc("/api/backup/download/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/api/backup/download/abc123" || r.URL.Query().Get("agent_id") != "backup.local" {
+			t.Errorf("download %s", r.URL)
+		}
+		w.Write(m.archive)
+	})
+	mux.HandleFunc("/api/backup/upload", func(w http.ResponseWriter, r *http.Request) {
+		f, _, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		m.uploaded, _ = io.ReadAll(f)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]string{"backup_id": "uploaded1"})
+	})
+	m.server = httptest.NewServer(mux)
+	t.Cleanup(m.server.Close)
+	return m
+}
+
+func (m *mockHA) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := m.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	conn.WriteJSON(map[string]any{"type": "auth_required", "ha_version": "2025.10.1"})
+	var auth map[string]string
+	if err := conn.ReadJSON(&auth); err != nil {
+		return
+	}
+	if auth["access_token"] != testToken {
+		conn.WriteJSON(map[string]any{"type": "auth_invalid", "message": "Invalid access token"})
+		return
+	}
+	conn.WriteJSON(map[string]any{"type": "auth_ok", "ha_version": "2025.10.1"})
+
+	result := func(id float64, res any) {
+		conn.WriteJSON(map[string]any{"id": id, "type": "result", "success": true, "result": res})
+	}
+	var subID float64
+	for {
+		var cmd map[string]any
+		if err := conn.ReadJSON(&cmd); err != nil {
+			return
+		}
+		id, _ := cmd["id"].(float64)
+		switch cmd["type"] {
+		case "backup/agents/info":
+			result(id, map[string]any{"agents": []map[string]string{{"agent_id": "cloud.cloud"}, {"agent_id": "backup.local"}}})
+		case "backup/subscribe_events":
+			subID = id
+			result(id, nil)
+			conn.WriteJSON(map[string]any{"id": id, "type": "event", "event": map[string]any{"manager_state": "idle"}})
+		case "backup/generate":
+			m.backupName, _ = cmd["name"].(string)
+			// The first event arrives before the command result.
+			conn.WriteJSON(map[string]any{"id": subID, "type": "event", "event": map[string]any{
+				"manager_state": "create_backup", "stage": "home_assistant", "state": "in_progress"}})
+			result(id, map[string]string{"backup_job_id": "job1"})
+			final := map[string]any{"manager_state": "create_backup", "stage": nil, "state": "completed"}
+			if m.failBackup {
+				final = map[string]any{"manager_state": "create_backup", "state": "failed", "reason": "upload_failed"}
+			}
+			conn.WriteJSON(map[string]any{"id": subID, "type": "event", "event": final})
+		case "backup/info":
+			result(id, map[string]any{"backups": []map[string]any{
+				{"backup_id": "old", "name": "Automatic backup", "date": "2026-10-01T03:00:00+00:00"},
+				{"backup_id": "abc123", "name": m.backupName, "date": "2026-10-16T03:00:00+00:00"},
+			}})
+		case "backup/restore":
+			m.restore = cmd
+			// Home Assistant shuts down without answering.
+			return
+		default:
+			conn.WriteJSON(map[string]any{"id": id, "type": "result", "success": false,
+				"error": map[string]string{"code": "unknown_command", "message": "Unknown command."}})
+		}
+	}
+}
+
+// haArchive builds a backup tar with the given backup.json.
+func haArchive(t *testing.T, meta string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, data := range map[string]string{"./backup.json": meta, "./homeassistant.tar.gz": "gz"} {
+		tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), Typeflag: tar.TypeReg})
+		tw.Write([]byte(data))
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestBackup(t *testing.T) {
+	archive := haArchive(t, `{"slug":"abc123","protected":false}`)
+	m := newMockHA(t, archive)
+
+	client, err := NewClient("homeassistant", m.server.URL, testToken, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, body, err := client.Backup(context.Background())
+	if err != nil {
+		t.Fatalf("Backup: %v", err)
+	}
+	defer body.Close()
+	data, _ := io.ReadAll(body)
+	if !bytes.Equal(data, archive) {
+		t.Error("downloaded archive differs")
+	}
+	if result.Name != "abc123.tar" || result.AppVersion != "2025.10.1" || result.CreatedAt.Day() != 16 {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestBackup_TLSVerification(t *testing.T) {
+	archive := haArchive(t, `{"slug":"abc123","protected":false}`)
+	m := newMockHA(t, archive)
+	srv := httptest.NewTLSServer(m.server.Config.Handler)
+	t.Cleanup(srv.Close)
+
+	// The test server's certificate is self-signed.
+	client, _ := NewClient("homeassistant", srv.URL, testToken, Options{})
+	if _, _, err := client.Backup(context.Background()); err == nil || !strings.Contains(err.Error(), "certificate") {
+		t.Errorf("err = %v, want certificate error", err)
+	}
+
+	client, _ = NewClient("homeassistant", srv.URL, testToken, Options{InsecureSkipVerify: true})
+	_, body, err := client.Backup(context.Background())
+	if err != nil {
+		t.Fatalf("Backup with InsecureSkipVerify: %v", err)
+	}
+	defer body.Close()
+	if data, _ := io.ReadAll(body); !bytes.Equal(data, archive) {
+		t.Error("downloaded archive differs")
+	}
+}
+
+func TestBackup_Failed(t *testing.T) {
+	m := newMockHA(t, nil)
+	m.failBackup = true
+
+	client, _ := NewClient("homeassistant", m.server.URL, testToken, Options{})
+	if _, _, err := client.Backup(context.Background()); err == nil || !strings.Contains(err.Error(), "upload_failed") {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestBackup_AuthFailure(t *testing.T) {
+	m := newMockHA(t, nil)
+
+	client, _ := NewClient("homeassistant", m.server.URL, "wrong", Options{})
+	if _, _, err := client.Backup(context.Background()); err == nil || !strings.Contains(err.Error(), "authentication failed") {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	archive := haArchive(t, `{"slug":"abc123","protected":false,"addons":[{"slug":"core_ssh"}],"folders":["share"]}`)
+	m := newMockHA(t, nil)
+	client, _ := NewClient("homeassistant", m.server.URL, testToken, Options{})
+
+	plan, err := client.Restore(context.Background(), bytes.NewReader(archive), backup.RestoreOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if !plan.Restart || !strings.Contains(strings.Join(plan.Notes, "\n"), "core_ssh") {
+		t.Errorf("plan = %+v", plan)
+	}
+	if m.uploaded != nil {
+		t.Fatal("dry run uploaded the backup")
+	}
+
+	if _, err := client.Restore(context.Background(), bytes.NewReader(archive), backup.RestoreOptions{}); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if !bytes.Equal(m.uploaded, archive) {
+		t.Error("uploaded archive differs")
+	}
+	if m.restore["backup_id"] != "uploaded1" || m.restore["agent_id"] != "backup.local" {
+		t.Errorf("restore command = %v", m.restore)
+	}
+	if addons, _ := m.restore["restore_addons"].([]any); len(addons) != 1 || addons[0] != "core_ssh" {
+		t.Errorf("restore_addons = %v", m.restore["restore_addons"])
+	}
+}
+
+func TestRestore_ProtectedWithoutPassword(t *testing.T) {
+	m := newMockHA(t, nil)
+	client, _ := NewClient("homeassistant", m.server.URL, testToken, Options{})
+
+	archive := haArchive(t, `{"slug":"abc123","protected":true}`)
+	if _, err := client.Restore(context.Background(), bytes.NewReader(archive), backup.RestoreOptions{DryRun: true}); err == nil {
+		t.Error("expected error for protected backup without password")
+	}
+	if _, err := client.Restore(context.Background(), strings.NewReader("not a tar"), backup.RestoreOptions{DryRun: true}); err == nil {
+		t.Error("expected error for non-tar data")
+	}
+}
@@ -0,0 +1,462 @@
+// Package jellyfin implements a backup.Client for Jellyfin and Emby media
+// servers.
+//
+// Jellyfin 10.11 and later have a built-in backup API:
+//  1. GET /System/Info/Public to read the server version
+//  2. POST /Backup/Create, which writes an archive into the server's
+//     backups directory and returns its manifest
+//  3. The archive is read from that directory, which must be mounted where
+//     backuparr runs: the API does not serve the archive over HTTP. Once
+//     it has been stored on a backend Cleanup deletes it, so archives do
+//     not pile up on the server
+//
+// Restore copies the archive back into the backups directory, finds its
+// server-side path through GET /Backup and calls POST /Backup/Restore.
+// Jellyfin then restarts and applies the archive.
+//
+// Older Jellyfin versions and Emby have no backup API. For them the
+// server's data directory is archived with the same SQLite-aware logic as
+// the files client, and restore writes it back and restarts the server
+// through POST /System/Restart.
+package jellyfin
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"backuparr/internal/backup"
+	"backuparr/internal/dirbackup"
+)
+
+// Verify Client satisfies the backup.Client and backup.Cleaner interfaces
+// at compile time.
+var (
+	_ backup.Client  = (*Client)(nil)
+	_ backup.Cleaner = (*Client)(nil)
+)
+
+// manifestEntry is the file at the root of every Jellyfin backup archive.
+const manifestEntry = "manifest.json"
+
+// defaultExcludes are data directory paths that Jellyfin and Emby rebuild
+// on their own and that can be very large.
+var defaultExcludes = []string{"cache/*", "log/*", "logs/*", "transcodes/*", "metadata/*"}
+
+// Config configures a Jellyfin or Emby client.
+type Config struct {
+	URL    string
+	APIKey string
+
+	// Emby selects Emby, which is always backed up in filesystem mode.
+	Emby bool
+
+	// BackupPath is where the server's backups directory is mounted. It is
+	// required for the backup API.
+	BackupPath string
+	// DataPath is where the server's data directory is mounted. It is
+	// required for filesystem mode.
+	DataPath string
+	// Excludes are glob patterns left out of filesystem backups, in
+	// addition to the cache, log, transcode and metadata directories.
+	Excludes []string
+
+	// Metadata, Subtitles and Trickplay add those items to API backups,
+	// which otherwise hold only the database and configuration.
+	Metadata  bool
+	Subtitles bool
+	Trickplay bool
+}
+
+// Client backs up a Jellyfin or Emby server.
+type Client struct {
+	appName    string
+	cfg        Config
+	httpClient *http.Client
+}
+
+// NewClient creates a client for the server at cfg.URL. At least one of
+// cfg.BackupPath and cfg.DataPath must be set, and Emby needs DataPath.
+func NewClient(appName string, cfg Config) (*Client, error) {
+	if appName == "" {
+		return nil, fmt.Errorf("app name is required for jellyfin client")
+	}
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("%s url is required", appName)
+	}
+	if cfg.APIKey == "" {
+		return nil, fmt.Errorf("%s api key is required", appName)
+	}
+	if cfg.Emby && cfg.DataPath == "" {
+		return nil, fmt.Errorf("emby requires a data path")
+	}
+	if cfg.BackupPath == "" && cfg.DataPath == "" {
+		return nil, fmt.Errorf("%s requires a backup path or a data path", appName)
+	}
+	for _, dir := range []string{cfg.BackupPath, cfg.DataPath} {
+		if dir == "" {
+			continue
+		}
+		info, err := os.Stat(dir)
+		if err != nil {
+			return nil, fmt.Errorf("%s path %q: %w", appName, dir, err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("%s path %q is not a directory", appName, dir)
+		}
+	}
+	cfg.URL = strings.TrimSuffix(cfg.URL, "/")
+	return &Client{
+		appName: appName,
+		cfg:     cfg,
+		// No overall timeout: creating a backup with metadata can take a
+		// long time, and requests are bounded by ctx instead.
+		httpClient: &http.Client{Transport: backup.NewRetryTransport(nil)},
+	}, nil
+}
+
+// Name returns the configured application name.
+func (c *Client) Name() string {
+	return c.appName
+}
+
+func (c *Client) logf(format string, args ...any) {
+	log.Printf("[%s] "+format, append([]any{c.appName}, args...)...)
+}
+
+// serverInfo is the subset of /System/Info/Public used here.
+type serverInfo struct {
+	Version     string `json:"Version"`
+	ProductName string `json:"ProductName"`
+}
+
+// backupManifest is the subset of Jellyfin's BackupManifestDto used here.
+type backupManifest struct {
+	ServerVersion string    `json:"ServerVersion"`
+	DateCreated   time.Time `json:"DateCreated"`
+	Path          string    `json:"Path"`
+}
+
+// hasBackupAPI reports whether a server version has the backup API
+// (Jellyfin 10.11 and later).
+func hasBackupAPI(version string) bool {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return false
+	}
+	major, err1 := strconv.Atoi(parts[0])
+	minor, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	return major > 10 || (major == 10 && minor >= 11)
+}
+
+// Backup creates a backup with the server's backup API when it has one and
+// a backup path is configured, and archives the data directory otherwise.
+func (c *Client) Backup(ctx context.Context) (*backup.BackupResult, io.ReadCloser, error) {
+	var info serverInfo
+	if err := c.do(ctx, http.MethodGet, "/System/Info/Public", nil, &info); err != nil {
+		return nil, nil, fmt.Errorf("failed to get server info: %w", err)
+	}
+	c.logf("Connected to %s %s", info.ProductName, info.Version)
+
+	if !c.cfg.Emby && hasBackupAPI(info.Version) && c.cfg.BackupPath != "" {
+		return c.backupAPI(ctx, info.Version)
+	}
+	if c.cfg.DataPath == "" {
+		return nil, nil, fmt.Errorf("%s %s has no backup API and no data path is configured", info.ProductName, info.Version)
+	}
+	return c.backupFiles(ctx, info.Version)
+}
+
+func (c *Client) backupAPI(ctx context.Context, version string) (*backup.BackupResult, io.ReadCloser, error) {
+	c.logf("Creating backup with the server backup API...")
+	opts := map[string]bool{
+		"Metadata":  c.cfg.Metadata,
+		"Subtitles": c.cfg.Subtitles,
+		"Trickplay": c.cfg.Trickplay,
+		"Database":  true,
+	}
+	var manifest backupManifest
+	if err := c.do(ctx, http.MethodPost, "/Backup/Create", opts, &manifest); err != nil {
+		return nil, nil, fmt.Errorf("failed to create backup: %w", err)
+	}
+	if manifest.Path == "" {
+		return nil, nil, fmt.Errorf("backup response has no archive path")
+	}
+
+	local := filepath.Join(c.cfg.BackupPath, serverBase(manifest.Path))
+	f, err := os.Open(local)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open backup archive %s (is the server's backups directory mounted at %s?): %w", manifest.Path, c.cfg.BackupPath, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, fmt.Errorf("failed to stat backup archive: %w", err)
+	}
+
+	c.logf("Backup created: %s (%d bytes)", manifest.Path, info.Size())
+	createdAt := manifest.DateCreated
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	return &backup.BackupResult{
+		Name:       serverBase(manifest.Path),
+		Path:       manifest.Path,
+		Size:       info.Size(),
+		CreatedAt:  createdAt,
+		AppVersion: version,
+		DBType:     backup.DBTypeSQLite,
+	}, f, nil
+}
+
+// Cleanup deletes an archive created by the backup API from the server's
+// backups directory. Filesystem backups leave nothing behind.
+func (c *Client) Cleanup(ctx context.Context, result *backup.BackupResult) error {
+	if c.cfg.BackupPath == "" || result.Name == c.filesBackupName() {
+		return nil
+	}
+	if err := os.Remove(filepath.Join(c.cfg.BackupPath, serverBase(result.Path))); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove backup archive from the server: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) backupFiles(ctx context.Context, version string) (*backup.BackupResult, io.ReadCloser, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	dir := c.dir()
+
+	pr, pw := io.Pipe()
+	go func() {
+		stats, err := dir.Backup(pw)
+		if err == nil {
+			c.logf("Backup complete: %d files (%d SQLite), %d bytes",
+				stats.TotalFiles, stats.SQLiteFiles, stats.TotalBytes)
+		}
+		pw.CloseWithError(err)
+	}()
+
+	c.logf("Archiving data directory %s", dir.Path)
+	return &backup.BackupResult{
+		Name:       c.filesBackupName(),
+		Path:       dir.Path,
+		CreatedAt:  time.Now(),
+		AppVersion: version,
+		DBType:     backup.DBTypeSQLite,
+	}, pr, nil
+}
+
+func (c *Client) filesBackupName() string {
+	return fmt.Sprintf("%s-files-backup", c.appName)
+}
+
+func (c *Client) dir() *dirbackup.Dir {
+	return &dirbackup.Dir{
+		Path:      c.cfg.DataPath,
+		Excludes:  append(append([]string{}, defaultExcludes...), c.cfg.Excludes...),
+		LogPrefix: c.appName,
+	}
+}
+
+// Restore applies a backup taken by Backup. Archives from the backup API
+// are handed back to the server, which restarts to apply them; filesystem
+// archives are extracted into the data directory before a restart.
+func (c *Client) Restore(ctx context.Context, backupData io.Reader, opts backup.RestoreOptions) (*backup.RestorePlan, error) {
+	spool, err := backup.Spool(backupData, "backuparr-jellyfin-*.zip")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup data: %w", err)
+	}
+	defer spool.Close()
+
+	zr, err := zip.NewReader(spool, spool.Size())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open backup zip: %w", err)
+	}
+	for _, f := range zr.File {
+		if f.Name == manifestEntry {
+			return c.restoreAPI(ctx, spool, opts)
+		}
+	}
+	return c.restoreFiles(ctx, spool, opts)
+}
+
+func (c *Client) restoreAPI(ctx context.Context, spool *backup.SpoolFile, opts backup.RestoreOptions) (*backup.RestorePlan, error) {
+	if c.cfg.Emby {
+		return nil, fmt.Errorf("backup was created by the Jellyfin backup API and cannot be restored to Emby")
+	}
+	if c.cfg.BackupPath == "" {
+		return nil, fmt.Errorf("restoring a backup API archive requires a backup path")
+	}
+
+	name := fmt.Sprintf("jellyfin-backup-%s.zip", time.Now().UTC().Format("20060102150405"))
+	local := filepath.Join(c.cfg.BackupPath, name)
+	plan := &backup.RestorePlan{
+		App:           c.appName,
+		DBType:        backup.DBTypeSQLite,
+		Files:         []backup.FileChange{{Path: local, Action: backup.FileCreate, Size: spool.Size()}},
+		Restart:       true,
+		RestartMethod: "Jellyfin API",
+		Notes:         []string{"Jellyfin replaces its database and configuration from the archive while it restarts"},
+	}
+	if opts.DryRun {
+		return plan, nil
+	}
+
+	if err := writeFile(local, spool); err != nil {
+		return nil, err
+	}
+	serverPath, err := c.serverArchivePath(ctx, name)
+	if err != nil {
+		os.Remove(local)
+		return nil, err
+	}
+
+	c.logf("Restoring %s...", serverPath)
+	body := map[string]string{"ArchiveFileName": serverPath}
+	if err := c.do(ctx, http.MethodPost, "/Backup/Restore", body, nil); err != nil {
+		return nil, fmt.Errorf("failed to start restore: %w", err)
+	}
+	c.logf("Restore scheduled, %s is restarting", c.appName)
+	return plan, nil
+}
+
+// serverArchivePath returns the server-side path of the archive named name
+// in the backups directory. The mount point seen by backuparr usually
+// differs from the server's own path, so it is looked up in the list of
+// backups the server knows about.
+func (c *Client) serverArchivePath(ctx context.Context, name string) (string, error) {
+	var manifests []backupManifest
+	if err := c.do(ctx, http.MethodGet, "/Backup", nil, &manifests); err != nil {
+		return "", fmt.Errorf("failed to list backups: %w", err)
+	}
+	for _, m := range manifests {
+		if serverBase(m.Path) == name {
+			return m.Path, nil
+		}
+	}
+	return "", fmt.Errorf("server does not list %s; check that the backup path is the server's backups directory", name)
+}
+
+func (c *Client) restoreFiles(ctx context.Context, spool *backup.SpoolFile, opts backup.RestoreOptions) (*backup.RestorePlan, error) {
+	if c.cfg.DataPath == "" {
+		return nil, fmt.Errorf("restoring a data directory archive requires a data path")
+	}
+	dir := c.dir()
+
+	plan := &backup.RestorePlan{
+		App:           c.appName,
+		DBType:        backup.DBTypeSQLite,
+		Restart:       true,
+		RestartMethod: c.appName + " API",
+	}
+	var err error
+	plan.Files, err = dir.PlanRestore(spool, spool.Size())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup zip: %w", err)
+	}
+	if opts.DryRun {
+		return plan, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	stats, err := dir.Restore(spool, spool.Size())
+	if err != nil {
+		return nil, fmt.Errorf("restore failed: %w", err)
+	}
+	c.logf("Restored %d files (%d bytes) into %s", stats.FilesRestored, stats.BytesRestored, dir.Path)
+
+	if err := c.do(ctx, http.MethodPost, "/System/Restart", nil, nil); err != nil {
+		c.logf("Warning: restart failed, restart %s manually: %v", c.appName, err)
+		plan.Restart = false
+		plan.RestartMethod = ""
+		plan.Notes = append(plan.Notes, fmt.Sprintf("restart %s manually so it picks up the restored files", c.appName))
+	}
+	return plan, nil
+}
+
+// do sends an authenticated API request with an optional JSON body and
+// decodes a JSON response into out when it is non-nil.
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.cfg.URL+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if c.cfg.Emby {
+		req.Header.Set("X-Emby-Token", c.cfg.APIKey)
+	} else {
+		req.Header.Set("Authorization", fmt.Sprintf("MediaBrowser Token=%q", c.cfg.APIKey))
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("%s %s: %d - %s", method, path, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", path, err)
+	}
+	return nil
+}
+
+// writeFile copies r into a new file at path.
+func writeFile(path string, r io.Reader) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		os.Remove(path)
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(path)
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// serverBase returns the file name of a server-side path, which may use
+// Windows separators.
+func serverBase(p string) string {
+	if i := strings.LastIndexAny(p, `/\`); i >= 0 {
+		return p[i+1:]
+	}
+	return p
+}
@@ -0,0 +1,236 @@
+package jellyfin
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"backuparr/internal/backup"
+)
+
+// fakeServer emulates the Jellyfin endpoints used by the client. Archives
+// live in backups, which the server reports under /config/data/backups.
+type fakeServer struct {
+	t        *testing.T
+	version  string
+	backups  string
+	restored string
+	restart  bool
+}
+
+func (s *fakeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/System/Info/Public" && r.Header.Get("Authorization") != `MediaBrowser Token="key"` {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	switch r.Method + " " + r.URL.Path {
+	case "GET /System/Info/Public":
+		json.NewEncoder(w).Encode(serverInfo{Version: s.version, ProductName: "Jellyfin Server"})
+	case "POST /Backup/Create":
+		var opts map[string]bool
+		json.NewDecoder(r.Body).Decode(&opts)
+		if !opts["Database"] || opts["Metadata"] {
+			s.t.Errorf("backup options = %v", opts)
+		}
+		name := "jellyfin-backup-20261016030000.zip"
+		os.WriteFile(filepath.Join(s.backups, name), archive(s.t, manifestEntry), 0o644)
+		json.NewEncoder(w).Encode(backupManifest{ServerVersion: s.version, Path: "/config/data/backups/" + name})
+	case "GET /Backup":
+		var list []backupManifest
+		entries, _ := os.ReadDir(s.backups)
+		for _, e := range entries {
+			list = append(list, backupManifest{Path: "/config/data/backups/" + e.Name()})
+		}
+		json.NewEncoder(w).Encode(list)
+	case "POST /Backup/Restore":
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		s.restored = body["ArchiveFileName"]
+		w.WriteHeader(http.StatusNoContent)
+	case "POST /System/Restart":
+		s.restart = true
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func archive(t *testing.T, names ...string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, name := range names {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte("{}"))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestHasBackupAPI(t *testing.T) {
+	for version, want := range map[string]bool{
+		"10.11.0":  true,
+		"10.11.2":  true,
+		"10.12.0":  true,
+		"11.0.0":   true,
+		"10.10.7":  false,
+		"10.9.11":  false,
+		"4.8.10.0": false, // Emby
+		"":         false,
+	} {
+		if got := hasBackupAPI(version); got != want {
+			t.Errorf("hasBackupAPI(%q) = %v, want %v", version, got, want)
+		}
+	}
+}
+
+func TestBackupRestore_API(t *testing.T) {
+	srv := &fakeServer{t: t, version: "10.11.1", backups: t.TempDir()}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	client, err := NewClient("jellyfin", Config{URL: ts.URL, APIKey: "key", BackupPath: srv.backups})
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, reader, err := client.Backup(context.Background())
+	if err != nil {
+		t.Fatalf("Backup: %v", err)
+	}
+	data, _ := io.ReadAll(reader)
+	reader.Close()
+	if result.AppVersion != "10.11.1" || result.Size != int64(len(data)) || !strings.HasSuffix(result.Path, ".zip") {
+		t.Errorf("result = %+v", result)
+	}
+
+	plan, err := client.Restore(context.Background(), bytes.NewReader(data), backup.RestoreOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if !plan.Restart || len(plan.Files) != 1 || plan.Files[0].Action != backup.FileCreate {
+		t.Errorf("plan = %+v", plan)
+	}
+	if srv.restored != "" {
+		t.Fatal("dry run started a restore")
+	}
+
+	if _, err := client.Restore(context.Background(), bytes.NewReader(data), backup.RestoreOptions{}); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if !strings.HasPrefix(srv.restored, "/config/data/backups/jellyfin-backup-") {
+		t.Errorf("restored archive = %q", srv.restored)
+	}
+	if _, err := os.Stat(filepath.Join(srv.backups, serverBase(srv.restored))); err != nil {
+		t.Errorf("archive not copied into backups directory: %v", err)
+	}
+}
+
+func TestCleanup_RemovesServerArchive(t *testing.T) {
+	srv := &fakeServer{t: t, version: "10.11.1", backups: t.TempDir()}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+	client, err := NewClient("jellyfin", Config{URL: ts.URL, APIKey: "key", BackupPath: srv.backups})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Reading the archive in full must not delete it: the upload may
+	// still fail on every backend.
+	result, reader, err := client.Backup(context.Background())
+	if err != nil {
+		t.Fatalf("Backup: %v", err)
+	}
+	if _, err := io.Copy(io.Discard, reader); err != nil {
+		t.Fatal(err)
+	}
+	if err := reader.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if entries, _ := os.ReadDir(srv.backups); len(entries) != 1 {
+		t.Fatalf("backups after read = %v, want the archive kept", entries)
+	}
+
+	if err := client.Cleanup(context.Background(), result); err != nil {
+		t.Fatalf("Cleanup: %v", err)
+	}
+	if entries, _ := os.ReadDir(srv.backups); len(entries) != 0 {
+		t.Errorf("backups after Cleanup = %v, want the archive removed", entries)
+	}
+}
+
+func TestBackupRestore_FilesystemFallback(t *testing.T) {
+	srv := &fakeServer{t: t, version: "10.10.7", backups: t.TempDir()}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	data := t.TempDir()
+	os.MkdirAll(filepath.Join(data, "config"), 0o755)
+	os.MkdirAll(filepath.Join(data, "cache"), 0o755)
+	os.WriteFile(filepath.Join(data, "config", "system.xml"), []byte("<ServerConfiguration/>"), 0o644)
+	os.WriteFile(filepath.Join(data, "cache", "image.jpg"), []byte("jpg"), 0o644)
+
+	client, err := NewClient("jellyfin", Config{URL: ts.URL, APIKey: "key", BackupPath: srv.backups, DataPath: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, reader, err := client.Backup(context.Background())
+	if err != nil {
+		t.Fatalf("Backup: %v", err)
+	}
+	zipData, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		t.Fatalf("read backup: %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(zipData), int64(len(zipData)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := map[string]bool{}
+	for _, f := range zr.File {
+		entries[f.Name] = true
+	}
+	if !entries["config/system.xml"] || entries["cache/image.jpg"] {
+		t.Errorf("entries = %v", entries)
+	}
+
+	os.WriteFile(filepath.Join(data, "config", "system.xml"), []byte("changed"), 0o644)
+	plan, err := client.Restore(context.Background(), bytes.NewReader(zipData), backup.RestoreOptions{})
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	restored, _ := os.ReadFile(filepath.Join(data, "config", "system.xml"))
+	if string(restored) != "<ServerConfiguration/>" {
+		t.Errorf("system.xml = %q", restored)
+	}
+	if !srv.restart || !plan.Restart {
+		t.Errorf("server not restarted: plan = %+v", plan)
+	}
+}
+
+func TestBackup_NoAPIWithoutDataPath(t *testing.T) {
+	srv := &fakeServer{t: t, version: "10.10.7", backups: t.TempDir()}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	client, err := NewClient("jellyfin", Config{URL: ts.URL, APIKey: "key", BackupPath: srv.backups})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Backup(context.Background()); err == nil || !strings.Contains(err.Error(), "no backup API") {
+		t.Errorf("err = %v", err)
+	}
+}
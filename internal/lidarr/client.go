@@ -0,0 +1,28 @@
+package lidarr
+
+import (
+	"fmt"
+
+	"backuparr/internal/arr"
+	"backuparr/internal/backup"
+)
+
+// Ensure LidarrClient implements backup.Client
+var _ backup.Client = (*LidarrClient)(nil)
+
+// app describes Lidarr to the shared *arr client: the /api/v1 endpoints, with PostgreSQL support.
+var app = arr.App{Name: "lidarr", APIVersion: "v1", Postgres: true}
+
+// LidarrClient backs up and restores Lidarr through the shared *arr client.
+type LidarrClient struct {
+	*arr.Client
+}
+
+// NewLidarrClient creates a new Lidarr API client with API key authentication
+func NewLidarrClient(baseURL, apiKey, username, password string, pgOverride *backup.PostgresConfig) (*LidarrClient, error) {
+	client, err := arr.New(app, baseURL, apiKey, username, password, pgOverride)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create lidarr client: %w", err)
+	}
+	return &LidarrClient{Client: client}, nil
+}
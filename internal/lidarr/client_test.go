@@ -0,0 +1,164 @@
+package lidarr
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"backuparr/internal/backup"
+)
+
+// mockLidarr serves the v1 endpoints the client uses, with forms
+// authentication enabled for backup downloads.
+type mockLidarr struct {
+	server   *httptest.Server
+	polls    int
+	uploads  int
+	restarts int
+}
+
+func newMockLidarr(t *testing.T) *mockLidarr {
+	m := &mockLidarr{}
+	mux := http.NewServeMux()
+
+	writeJSON := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(v)
+	}
+
+	mux.HandleFunc("POST /api/v1/command", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{"id": 7, "status": "queued"})
+	})
+	mux.HandleFunc("GET /api/v1/command/{id}", func(w http.ResponseWriter, r *http.Request) {
+		m.polls++
+		writeJSON(w, map[string]any{"status": "completed"})
+	})
+	mux.HandleFunc("GET /api/v1/system/backup", func(w http.ResponseWriter, r *http.Request) {
+		name := "lidarr_backup_v2.9.6_2026.02.06_03.00.00.zip"
+		writeJSON(w, []map[string]any{{
+			"name": name,
+			"path": "/backup/manual/" + name,
+			"time": time.Date(2026, 2, 6, 3, 0, 0, 0, time.UTC),
+		}})
+	})
+	mux.HandleFunc("GET /api/v1/system/status", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{"version": "2.9.6.4552", "databaseType": "sqLite"})
+	})
+	mux.HandleFunc("GET /api/v1/config/host", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{"authenticationMethod": "forms"})
+	})
+	mux.HandleFunc("POST /login", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("username") == "admin" && r.FormValue("password") == "secret" {
+			http.SetCookie(w, &http.Cookie{Name: "LidarrAuth", Value: "session", Path: "/"})
+		}
+		w.WriteHeader(http.StatusFound)
+	})
+	mux.HandleFunc("GET /backup/manual/{name}", func(w http.ResponseWriter, r *http.Request) {
+		if c, err := r.Cookie("LidarrAuth"); err != nil || c.Value != "session" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/zip")
+		w.Write(makeZip(t))
+	})
+	mux.HandleFunc("POST /api/v1/system/backup/restore/upload", func(w http.ResponseWriter, r *http.Request) {
+		if _, _, err := r.FormFile("restore"); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		m.uploads++
+		writeJSON(w, map[string]bool{"RestartRequired": true})
+	})
+	mux.HandleFunc("POST /api/v1/system/restart", func(w http.ResponseWriter, r *http.Request) {
+		m.restarts++
+	})
+
+	m.server = httptest.NewServer(mux)
+	t.Cleanup(m.server.Close)
+	return m
+}
+
+func makeZip(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range []struct{ name, content string }{
+		{"config.xml", "<Config><ApiKey>test123</ApiKey></Config>"},
+		{"lidarr.db", "SQLite format 3\x00"},
+	} {
+		fw, err := zw.Create(f.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fw.Write([]byte(f.content))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestBackup(t *testing.T) {
+	mock := newMockLidarr(t)
+	client, err := NewLidarrClient(mock.server.URL, "k", "admin", "secret", nil)
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+
+	result, reader, err := client.Backup(context.Background())
+	if err != nil {
+		t.Fatalf("Backup: %v", err)
+	}
+	defer reader.Close()
+
+	if mock.polls == 0 {
+		t.Error("backup command was never polled")
+	}
+	if result.DBType != backup.DBTypeSQLite || result.AppVersion != "2.9.6.4552" {
+		t.Errorf("result = %+v", result)
+	}
+	data, _ := io.ReadAll(reader)
+	if !bytes.Equal(data, makeZip(t)) {
+		t.Error("downloaded backup does not match the served archive")
+	}
+}
+
+func TestBackup_FormsLoginRejected(t *testing.T) {
+	mock := newMockLidarr(t)
+	client, _ := NewLidarrClient(mock.server.URL, "k", "admin", "wrong", nil)
+
+	_, _, err := client.Backup(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "no auth cookie") {
+		t.Fatalf("err = %v, want forms login failure", err)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	mock := newMockLidarr(t)
+	client, _ := NewLidarrClient(mock.server.URL, "k", "", "", nil)
+
+	plan, err := client.Restore(context.Background(), bytes.NewReader(makeZip(t)), backup.RestoreOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if plan.App != "lidarr" || plan.DBType != backup.DBTypeSQLite || !plan.Restart {
+		t.Errorf("plan = %+v", plan)
+	}
+	if mock.uploads != 0 {
+		t.Fatal("dry run uploaded the backup")
+	}
+
+	if _, err := client.Restore(context.Background(), bytes.NewReader(makeZip(t)), backup.RestoreOptions{}); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if mock.uploads != 1 || mock.restarts != 1 {
+		t.Errorf("uploads = %d, restarts = %d, want 1 each", mock.uploads, mock.restarts)
+	}
+}
@@ -0,0 +1,148 @@
+// Package metrics exposes backup and restore outcomes in the Prometheus
+// text format. The web/daemon process serves them at /metrics.
+package metrics
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// Result label values.
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+var (
+	lastSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "backuparr_last_success_timestamp",
+		Help: "Unix time of the newest backup stored successfully on each backend.",
+	}, []string{"app", "backend"})
+
+	lastFailure = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "backuparr_last_failure_timestamp",
+		Help: "Unix time of the most recent failed upload to each backend.",
+	}, []string{"app", "backend"})
+
+	backupsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "backuparr_backups_total",
+		Help: "Backup runs by result. A run succeeds if at least one backend stored it.",
+	}, []string{"app", "result"})
+
+	backupDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "backuparr_backup_duration_seconds",
+		Help:    "Time taken to create and upload a backup.",
+		Buckets: []float64{1, 5, 15, 30, 60, 120, 300, 600, 1200, 1800, 3600},
+	}, []string{"app"})
+
+	backupSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "backuparr_backup_size_bytes",
+		Help: "Size of the most recent successful backup archive, before encryption.",
+	}, []string{"app"})
+
+	uploadFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "backuparr_upload_failures_total",
+		Help: "Failed uploads of a backup to a storage backend.",
+	}, []string{"app", "backend"})
+
+	retentionDeletions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "backuparr_retention_deletions_total",
+		Help: "Backups deleted by the retention policy.",
+	}, []string{"app", "backend"})
+
+	restoresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "backuparr_restores_total",
+		Help: "Restores by result. Dry runs are not counted.",
+	}, []string{"app", "result"})
+)
+
+var registry = prometheus.NewRegistry()
+
+func init() {
+	registry.MustRegister(
+		lastSuccess,
+		lastFailure,
+		backupsTotal,
+		backupDuration,
+		backupSize,
+		uploadFailures,
+		retentionDeletions,
+		restoresTotal,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler serves the registered metrics.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+func result(ok bool) string {
+	if ok {
+		return ResultSuccess
+	}
+	return ResultFailure
+}
+
+// ObserveBackup records the outcome of one backup run. size is only
+// recorded for successful runs.
+func ObserveBackup(app string, d time.Duration, size int64, ok bool) {
+	backupsTotal.WithLabelValues(app, result(ok)).Inc()
+	backupDuration.WithLabelValues(app).Observe(d.Seconds())
+	if ok {
+		backupSize.WithLabelValues(app).Set(float64(size))
+	}
+}
+
+// UploadSucceeded records a backup created at t being stored on backend.
+func UploadSucceeded(app, backend string, t time.Time) {
+	SeedLastSuccess(app, backend, t)
+}
+
+// UploadFailed records a failed upload to backend.
+func UploadFailed(app, backend string) {
+	uploadFailures.WithLabelValues(app, backend).Inc()
+	lastFailure.WithLabelValues(app, backend).Set(float64(time.Now().Unix()))
+}
+
+// SeedLastSuccess sets the last-success timestamp from an existing backup,
+// unless a newer one is already recorded. The daemon calls it at startup so
+// staleness alerts keep working across restarts.
+func SeedLastSuccess(app, backend string, t time.Time) {
+	lastSuccessMu.Lock()
+	defer lastSuccessMu.Unlock()
+	g := lastSuccess.WithLabelValues(app, backend)
+	if ts := float64(t.Unix()); ts > gaugeValue(g) {
+		g.Set(ts)
+	}
+}
+
+// lastSuccessMu makes SeedLastSuccess's read-compare-set atomic.
+var lastSuccessMu sync.Mutex
+
+func gaugeValue(g prometheus.Gauge) float64 {
+	var m dto.Metric
+	if err := g.Write(&m); err != nil {
+		return 0
+	}
+	return m.GetGauge().GetValue()
+}
+
+// RetentionDeleted records n backups pruned from backend.
+func RetentionDeleted(app, backend string, n int) {
+	if n > 0 {
+		retentionDeletions.WithLabelValues(app, backend).Add(float64(n))
+	}
+}
+
+// ObserveRestore records the outcome of a (non dry-run) restore.
+func ObserveRestore(app string, ok bool) {
+	restoresTotal.WithLabelValues(app, result(ok)).Inc()
+}
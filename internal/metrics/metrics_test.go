@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func scrape(t *testing.T) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := io.ReadAll(rec.Body)
+	return string(body)
+}
+
+func TestBackupMetrics(t *testing.T) {
+	created := time.Unix(1770000000, 0)
+	ObserveBackup("sonarr", 3*time.Second, 4096, true)
+	UploadSucceeded("sonarr", "s3", created)
+	UploadFailed("sonarr", "nas")
+	RetentionDeleted("sonarr", "s3", 2)
+	ObserveRestore("sonarr", false)
+
+	// An older backup found at startup must not move the timestamp back.
+	SeedLastSuccess("sonarr", "s3", created.Add(-time.Hour))
+
+	body := scrape(t)
+	for _, want := range []string{
+		`backuparr_last_success_timestamp{app="sonarr",backend="s3"} 1.77e+09`,
+		`backuparr_backups_total{app="sonarr",result="success"} 1`,
+		`backuparr_backup_duration_seconds_count{app="sonarr"} 1`,
+		`backuparr_backup_size_bytes{app="sonarr"} 4096`,
+		`backuparr_upload_failures_total{app="sonarr",backend="nas"} 1`,
+		`backuparr_retention_deletions_total{app="sonarr",backend="s3"} 2`,
+		`backuparr_restores_total{app="sonarr",result="failure"} 1`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics missing %q", want)
+		}
+	}
+}
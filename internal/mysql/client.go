@@ -0,0 +1,232 @@
+// Package mysql implements a backup.Client for MySQL and MariaDB databases.
+// Each configured database is dumped with mysqldump into mysql/<db>.sql of
+// the archive and restored by piping the dump through the mysql client.
+package mysql
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	"backuparr/internal/backup"
+)
+
+// Verify Client satisfies the backup.Client interface at compile time.
+var _ backup.Client = (*Client)(nil)
+
+// dumpDir is the archive directory holding the database dumps.
+const dumpDir = "mysql/"
+
+// Config holds MySQL/MariaDB connection details.
+type Config struct {
+	Host     string
+	Port     string
+	User     string
+	Password string
+}
+
+// Client backs up and restores a fixed list of databases on one server.
+type Client struct {
+	appName   string
+	cfg       Config
+	databases []string
+}
+
+// NewClient creates a client that dumps databases from the server in cfg.
+func NewClient(appName string, cfg Config, databases []string) (*Client, error) {
+	if appName == "" {
+		return nil, fmt.Errorf("app name is required for mysql client")
+	}
+	if cfg.Host == "" {
+		return nil, fmt.Errorf("mysql host is required")
+	}
+	if len(databases) == 0 {
+		return nil, fmt.Errorf("at least one database is required")
+	}
+	for _, db := range databases {
+		if db == "" || strings.ContainsAny(db, "/`") {
+			return nil, fmt.Errorf("invalid database name %q", db)
+		}
+	}
+	return &Client{appName: appName, cfg: cfg, databases: databases}, nil
+}
+
+// Name returns the configured application name.
+func (c *Client) Name() string {
+	return c.appName
+}
+
+// connArgs returns the connection flags shared by mysqldump and mysql. The
+// password is passed through MYSQL_PWD (see env) rather than argv.
+func (c *Client) connArgs() []string {
+	args := []string{"--host=" + c.cfg.Host, "--user=" + c.cfg.User}
+	if c.cfg.Port != "" {
+		args = append(args, "--port="+c.cfg.Port)
+	}
+	return args
+}
+
+func (c *Client) env(cmd *exec.Cmd) {
+	cmd.Env = append(cmd.Environ(), "MYSQL_PWD="+c.cfg.Password)
+}
+
+// Backup dumps every configured database into a ZIP stream. Dumps are
+// written through a pipe as they are produced, so nothing is buffered in
+// memory.
+func (c *Client) Backup(ctx context.Context) (*backup.BackupResult, io.ReadCloser, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		zw := zip.NewWriter(pw)
+		var err error
+		for _, db := range c.databases {
+			if err = c.dump(ctx, zw, db); err != nil {
+				break
+			}
+		}
+		if err == nil {
+			err = zw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	log.Printf("[%s] Dumping %d databases from %s", c.appName, len(c.databases), c.cfg.Host)
+	return &backup.BackupResult{
+		Name:      fmt.Sprintf("%s-mysql-backup", c.appName),
+		CreatedAt: time.Now(),
+		DBType:    backup.DBTypeMySQL,
+	}, pr, nil
+}
+
+// dump streams mysqldump output for db into a new archive entry. The dump
+// is taken in a single transaction, so InnoDB tables are consistent without
+// locking the database.
+func (c *Client) dump(ctx context.Context, zw *zip.Writer, db string) error {
+	writer, err := zw.CreateHeader(&zip.FileHeader{Name: dumpDir + db + ".sql", Method: zip.Deflate})
+	if err != nil {
+		return fmt.Errorf("failed to create %s.sql: %w", db, err)
+	}
+
+	args := append(c.connArgs(),
+		"--single-transaction",
+		"--routines",
+		"--triggers",
+		"--events",
+		"--no-tablespaces",
+		db,
+	)
+	cmd := exec.CommandContext(ctx, "mysqldump", args...)
+	c.env(cmd)
+	cmd.Stdout = writer
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("mysqldump %s failed: %w - %s", db, err, stderr.String())
+	}
+	return nil
+}
+
+// Restore loads each dump in the archive into the configured database of
+// the same name, creating the database if needed. mysqldump output drops
+// and recreates each table it contains; tables missing from the dump are
+// left in place.
+func (c *Client) Restore(ctx context.Context, backupData io.Reader, opts backup.RestoreOptions) (*backup.RestorePlan, error) {
+	spool, err := backup.Spool(backupData, "backuparr-mysql-*.zip")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup data: %w", err)
+	}
+	defer spool.Close()
+
+	reader, err := zip.NewReader(spool, spool.Size())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open backup zip: %w", err)
+	}
+
+	dumps := map[string]*zip.File{}
+	for _, f := range reader.File {
+		if !strings.HasPrefix(f.Name, dumpDir) || !strings.HasSuffix(f.Name, ".sql") {
+			continue
+		}
+		db := strings.TrimSuffix(strings.TrimPrefix(f.Name, dumpDir), ".sql")
+		if !c.configured(db) {
+			return nil, fmt.Errorf("dump %s does not match a configured database", f.Name)
+		}
+		dumps[db] = f
+	}
+	if len(dumps) == 0 {
+		return nil, fmt.Errorf("backup contains no mysql dumps")
+	}
+
+	plan := &backup.RestorePlan{
+		App:    c.appName,
+		DBType: backup.DBTypeMySQL,
+		Notes:  []string{"restart applications using these databases after the restore"},
+	}
+	dbs := make([]string, 0, len(dumps))
+	for db := range dumps {
+		dbs = append(dbs, db)
+	}
+	sort.Strings(dbs)
+	for _, db := range dbs {
+		plan.Notes = append(plan.Notes, fmt.Sprintf("tables in %s@%s/%s are dropped and reloaded from the dump", c.cfg.User, c.cfg.Host, db))
+	}
+
+	if opts.DryRun {
+		return plan, nil
+	}
+
+	for _, db := range dbs {
+		log.Printf("[%s] Restoring %s (%d bytes)...", c.appName, db, dumps[db].UncompressedSize64)
+		if err := c.restore(ctx, db, dumps[db]); err != nil {
+			return nil, err
+		}
+	}
+	log.Printf("[%s] MySQL databases restored successfully", c.appName)
+	return plan, nil
+}
+
+func (c *Client) restore(ctx context.Context, db string, f *zip.File) error {
+	create := exec.CommandContext(ctx, "mysql", append(c.connArgs(), "-e", "CREATE DATABASE IF NOT EXISTS `"+db+"`")...)
+	c.env(create)
+	var stderr bytes.Buffer
+	create.Stderr = &stderr
+	if err := create.Run(); err != nil {
+		return fmt.Errorf("failed to create database %s: %w - %s", db, err, stderr.String())
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	cmd := exec.CommandContext(ctx, "mysql", append(c.connArgs(), db)...)
+	c.env(cmd)
+	cmd.Stdin = rc
+	stderr.Reset()
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("mysql restore of %s failed: %w - %s", db, err, stderr.String())
+	}
+	return nil
+}
+
+func (c *Client) configured(db string) bool {
+	for _, name := range c.databases {
+		if name == db {
+			return true
+		}
+	}
+	return false
+}
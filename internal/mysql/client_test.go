@@ -0,0 +1,101 @@
+package mysql
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"backuparr/internal/backup"
+)
+
+// fakeTools installs mysqldump and mysql scripts on PATH. mysqldump prints
+// a dump of the database named by its last argument; mysql appends its
+// arguments, MYSQL_PWD and stdin to a log. It returns the log path.
+func fakeTools(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "mysql.log")
+	scripts := map[string]string{
+		"mysqldump": "#!/bin/sh\nfor db; do :; done\nprintf 'CREATE TABLE t (id int);\\n-- dump of %s\\n-- Dump completed\\n' \"$db\"\n",
+		"mysql":     "#!/bin/sh\necho \"args: $* pwd=$MYSQL_PWD\" >> " + logPath + "\ncat >> " + logPath + "\n",
+	}
+	for name, script := range scripts {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(script), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return logPath
+}
+
+func TestBackupRestore(t *testing.T) {
+	logPath := fakeTools(t)
+	client, err := NewClient("nextcloud", Config{Host: "db", Port: "3306", User: "nc", Password: "secret"}, []string{"nextcloud", "nextcloud_logs"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, reader, err := client.Backup(context.Background())
+	if err != nil {
+		t.Fatalf("Backup: %v", err)
+	}
+	data, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		t.Fatalf("read backup: %v", err)
+	}
+	if result.DBType != backup.DBTypeMySQL {
+		t.Errorf("DBType = %q", result.DBType)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zr.File) != 2 || zr.File[0].Name != "mysql/nextcloud.sql" || zr.File[1].Name != "mysql/nextcloud_logs.sql" {
+		t.Fatalf("entries = %v", zr.File)
+	}
+
+	plan, err := client.Restore(context.Background(), bytes.NewReader(data), backup.RestoreOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if plan.DBType != backup.DBTypeMySQL || len(plan.Notes) != 3 {
+		t.Errorf("plan = %+v", plan)
+	}
+	if _, err := os.Stat(logPath); !os.IsNotExist(err) {
+		t.Fatal("dry run ran mysql")
+	}
+
+	if _, err := client.Restore(context.Background(), bytes.NewReader(data), backup.RestoreOptions{}); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	log, _ := os.ReadFile(logPath)
+	for _, want := range []string{
+		"args: --host=db --user=nc --port=3306 -e CREATE DATABASE IF NOT EXISTS `nextcloud` pwd=secret",
+		"args: --host=db --user=nc --port=3306 nextcloud pwd=secret",
+		"-- dump of nextcloud_logs",
+	} {
+		if !strings.Contains(string(log), want) {
+			t.Errorf("mysql log missing %q:\n%s", want, log)
+		}
+	}
+}
+
+func TestRestore_UnknownDatabase(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	fw, _ := zw.Create("mysql/other.sql")
+	fw.Write([]byte("-- Dump completed"))
+	zw.Close()
+
+	client, _ := NewClient("nextcloud", Config{Host: "db"}, []string{"nextcloud"})
+	if _, err := client.Restore(context.Background(), bytes.NewReader(buf.Bytes()), backup.RestoreOptions{DryRun: true}); err == nil {
+		t.Error("dump for an unconfigured database accepted")
+	}
+}
@@ -0,0 +1,181 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+// post sends body to url and treats any non-2xx response as an error.
+func post(ctx context.Context, url, contentType string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// Webhook POSTs the event to an arbitrary URL, as JSON by default or as the
+// rendered template.
+type Webhook struct {
+	name    string
+	url     string
+	headers map[string]string
+	tmpl    *template.Template
+}
+
+func (w *Webhook) Name() string { return w.name }
+
+func (w *Webhook) Notify(ctx context.Context, e Event) error {
+	if w.tmpl == nil {
+		body, err := json.Marshal(struct {
+			Event
+			Title   string `json:"title"`
+			Message string `json:"message"`
+		}{e, e.Title(), e.Message()})
+		if err != nil {
+			return err
+		}
+		return post(ctx, w.url, "application/json", body, w.headers)
+	}
+
+	body, err := render(w.tmpl, e)
+	if err != nil {
+		return err
+	}
+	contentType := "text/plain; charset=utf-8"
+	if json.Valid([]byte(body)) {
+		contentType = "application/json"
+	}
+	return post(ctx, w.url, contentType, []byte(body), w.headers)
+}
+
+// Ntfy publishes to an ntfy topic URL (https://ntfy.sh/<topic> or a
+// self-hosted server).
+type Ntfy struct {
+	name     string
+	url      string
+	token    string
+	priority int
+	tmpl     *template.Template
+}
+
+func (n *Ntfy) Name() string { return n.name }
+
+func (n *Ntfy) Notify(ctx context.Context, e Event) error {
+	msg, err := render(n.tmpl, e)
+	if err != nil {
+		return err
+	}
+
+	headers := map[string]string{"Title": e.Title()}
+	if e.Success {
+		headers["Tags"] = "white_check_mark"
+	} else {
+		headers["Tags"] = "rotating_light"
+	}
+	priority := n.priority
+	if priority == 0 && !e.Success {
+		priority = 4 // high
+	}
+	if priority != 0 {
+		headers["Priority"] = strconv.Itoa(priority)
+	}
+	if n.token != "" {
+		headers["Authorization"] = "Bearer " + n.token
+	}
+	return post(ctx, n.url, "text/plain; charset=utf-8", []byte(msg), headers)
+}
+
+// Gotify sends a message to a Gotify server using an application token.
+type Gotify struct {
+	name     string
+	url      string
+	token    string
+	priority int
+	tmpl     *template.Template
+}
+
+func (g *Gotify) Name() string { return g.name }
+
+func (g *Gotify) Notify(ctx context.Context, e Event) error {
+	msg, err := render(g.tmpl, e)
+	if err != nil {
+		return err
+	}
+
+	priority := g.priority
+	if priority == 0 && !e.Success {
+		priority = 8
+	}
+	body, err := json.Marshal(map[string]any{
+		"title":    e.Title(),
+		"message":  msg,
+		"priority": priority,
+	})
+	if err != nil {
+		return err
+	}
+	url := strings.TrimRight(g.url, "/") + "/message"
+	return post(ctx, url, "application/json", body, map[string]string{"X-Gotify-Key": g.token})
+}
+
+// discordMaxContent is Discord's limit on a webhook message's content.
+const discordMaxContent = 2000
+
+// ChatWebhook posts to a Discord or Slack incoming webhook.
+type ChatWebhook struct {
+	name  string
+	url   string
+	slack bool
+	tmpl  *template.Template
+}
+
+func (c *ChatWebhook) Name() string { return c.name }
+
+func (c *ChatWebhook) Notify(ctx context.Context, e Event) error {
+	msg, err := render(c.tmpl, e)
+	if err != nil {
+		return err
+	}
+	text := "**" + e.Title() + "**\n" + msg
+
+	var payload map[string]string
+	if c.slack {
+		payload = map[string]string{"text": "*" + e.Title() + "*\n" + msg}
+	} else {
+		if len(text) > discordMaxContent {
+			text = strings.ToValidUTF8(text[:discordMaxContent-3], "") + "..."
+		}
+		payload = map[string]string{"content": text}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return post(ctx, c.url, "application/json", body, nil)
+}
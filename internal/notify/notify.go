@@ -0,0 +1,223 @@
+// Package notify sends a message to configured targets (webhooks, ntfy,
+// Gotify, Discord, Slack, email) when a backup or restore job finishes.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"backuparr/internal/config"
+)
+
+// Event describes a finished backup or restore job.
+type Event struct {
+	Kind      string    `json:"kind"` // "backup" or "restore"
+	JobID     string    `json:"jobId,omitempty"`
+	Trigger   string    `json:"trigger"` // "manual", "schedule" or "cli"
+	Success   bool      `json:"success"`
+	Results   []Result  `json:"results"`
+	StartedAt time.Time `json:"startedAt"`
+	EndedAt   time.Time `json:"endedAt"`
+	Host      string    `json:"host,omitempty"` // host running backuparr
+}
+
+// Result is the outcome for one app within a job.
+type Result struct {
+	App    string `json:"app"`
+	OK     bool   `json:"ok"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Failed returns the results that did not succeed.
+func (e Event) Failed() []Result {
+	var failed []Result
+	for _, r := range e.Results {
+		if !r.OK {
+			failed = append(failed, r)
+		}
+	}
+	return failed
+}
+
+// Title is a one-line summary such as "Backuparr backup failed: sonarr".
+func (e Event) Title() string {
+	if e.Success {
+		return fmt.Sprintf("Backuparr %s succeeded", e.Kind)
+	}
+	var apps []string
+	for _, r := range e.Failed() {
+		apps = append(apps, r.App)
+	}
+	if len(apps) == 0 {
+		return fmt.Sprintf("Backuparr %s failed", e.Kind)
+	}
+	return fmt.Sprintf("Backuparr %s failed: %s", e.Kind, strings.Join(apps, ", "))
+}
+
+// Message is the default plain-text body: one line per app.
+func (e Event) Message() string {
+	var b strings.Builder
+	for _, r := range e.Results {
+		if r.OK {
+			fmt.Fprintf(&b, "%s: ok\n", r.App)
+		} else {
+			fmt.Fprintf(&b, "%s: %s: %s\n", r.App, r.Status, r.Error)
+		}
+	}
+	if len(e.Results) == 0 && !e.Success {
+		b.WriteString("the job failed before any app was processed\n")
+	}
+	fmt.Fprintf(&b, "Trigger: %s, took %s", e.Trigger, e.EndedAt.Sub(e.StartedAt).Round(time.Second))
+	if e.Host != "" {
+		fmt.Fprintf(&b, ", on %s", e.Host)
+	}
+	return b.String()
+}
+
+// Notifier delivers an event to one target.
+type Notifier interface {
+	Name() string
+	Notify(ctx context.Context, e Event) error
+}
+
+var (
+	_ Notifier = (*Webhook)(nil)
+	_ Notifier = (*Ntfy)(nil)
+	_ Notifier = (*Gotify)(nil)
+	_ Notifier = (*ChatWebhook)(nil)
+	_ Notifier = (*SMTP)(nil)
+)
+
+// target pairs a notifier with its filter.
+type target struct {
+	notifier Notifier
+	always   bool
+}
+
+// Dispatcher fans an event out to every configured target whose filter
+// matches. A nil *Dispatcher is valid and sends nothing.
+type Dispatcher struct {
+	targets []target
+	timeout time.Duration
+}
+
+// New builds a Dispatcher from the notifications config.
+func New(cfgs []config.NotificationConfig) (*Dispatcher, error) {
+	d := &Dispatcher{timeout: 30 * time.Second}
+	for i, cfg := range cfgs {
+		name := cfg.Name
+		if name == "" {
+			name = cfg.Type
+		}
+
+		var always bool
+		switch cfg.On {
+		case "", config.NotifyOnFailure:
+		case config.NotifyAlways:
+			always = true
+		default:
+			return nil, fmt.Errorf("notifications[%d] (%s): invalid on %q (want %q or %q)", i, name, cfg.On, config.NotifyOnFailure, config.NotifyAlways)
+		}
+
+		var tmpl *template.Template
+		if cfg.Template != "" {
+			var err error
+			tmpl, err = template.New(name).Parse(cfg.Template)
+			if err != nil {
+				return nil, fmt.Errorf("notifications[%d] (%s): invalid template: %w", i, name, err)
+			}
+		}
+
+		n, err := newNotifier(name, cfg, tmpl)
+		if err != nil {
+			return nil, fmt.Errorf("notifications[%d] (%s): %w", i, name, err)
+		}
+		d.targets = append(d.targets, target{notifier: n, always: always})
+	}
+	return d, nil
+}
+
+func newNotifier(name string, cfg config.NotificationConfig, tmpl *template.Template) (Notifier, error) {
+	needURL := func() error {
+		if cfg.URL == "" {
+			return fmt.Errorf("url is required for %s notifications", cfg.Type)
+		}
+		return nil
+	}
+
+	switch cfg.Type {
+	case "webhook":
+		if err := needURL(); err != nil {
+			return nil, err
+		}
+		return &Webhook{name: name, url: cfg.URL, headers: cfg.Headers, tmpl: tmpl}, nil
+	case "ntfy":
+		if err := needURL(); err != nil {
+			return nil, err
+		}
+		return &Ntfy{name: name, url: cfg.URL, token: cfg.Token, priority: cfg.Priority, tmpl: tmpl}, nil
+	case "gotify":
+		if err := needURL(); err != nil {
+			return nil, err
+		}
+		if cfg.Token == "" {
+			return nil, fmt.Errorf("token is required for gotify notifications")
+		}
+		return &Gotify{name: name, url: cfg.URL, token: cfg.Token, priority: cfg.Priority, tmpl: tmpl}, nil
+	case "discord", "slack":
+		if err := needURL(); err != nil {
+			return nil, err
+		}
+		return &ChatWebhook{name: name, url: cfg.URL, slack: cfg.Type == "slack", tmpl: tmpl}, nil
+	case "smtp":
+		return newSMTP(name, cfg, tmpl)
+	default:
+		return nil, fmt.Errorf("unknown notification type %q", cfg.Type)
+	}
+}
+
+// Send notifies every matching target concurrently and waits for them.
+// Delivery failures are logged, never returned: a broken notification
+// target must not fail the backup it reports on.
+func (d *Dispatcher) Send(ctx context.Context, e Event) {
+	if d == nil {
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, t := range d.targets {
+		if e.Success && !t.always {
+			continue
+		}
+		wg.Add(1)
+		go func(n Notifier) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, d.timeout)
+			defer cancel()
+			if err := n.Notify(ctx, e); err != nil {
+				log.Printf("Notification to %s failed: %v", n.Name(), err)
+			}
+		}(t.notifier)
+	}
+	wg.Wait()
+}
+
+// render returns the message text: tmpl executed with e, or the default
+// message when tmpl is nil.
+func render(tmpl *template.Template, e Event) (string, error) {
+	if tmpl == nil {
+		return e.Message(), nil
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, e); err != nil {
+		return "", fmt.Errorf("failed to render template: %w", err)
+	}
+	return buf.String(), nil
+}
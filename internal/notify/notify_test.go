@@ -0,0 +1,281 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"backuparr/internal/config"
+)
+
+func failedEvent() Event {
+	start := time.Date(2026, 2, 6, 3, 0, 0, 0, time.UTC)
+	return Event{
+		Kind:    "backup",
+		Trigger: "schedule",
+		Success: false,
+		Results: []Result{
+			{App: "sonarr", OK: true, Status: "ok"},
+			{App: "radarr", OK: false, Status: "failed", Error: "connection refused"},
+		},
+		StartedAt: start,
+		EndedAt:   start.Add(90 * time.Second),
+	}
+}
+
+type capture struct {
+	mu      sync.Mutex
+	path    string
+	headers http.Header
+	body    []byte
+	calls   int
+}
+
+func (c *capture) server(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.path = r.URL.Path
+		c.headers = r.Header.Clone()
+		c.body, _ = io.ReadAll(r.Body)
+		c.calls++
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func send(t *testing.T, cfg config.NotificationConfig, e Event) {
+	t.Helper()
+	d, err := New([]config.NotificationConfig{cfg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Send(context.Background(), e)
+}
+
+func TestEventText(t *testing.T) {
+	e := failedEvent()
+	if got := e.Title(); got != "Backuparr backup failed: radarr" {
+		t.Errorf("Title() = %q", got)
+	}
+	msg := e.Message()
+	for _, want := range []string{"sonarr: ok", "radarr: failed: connection refused", "took 1m30s"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Message() = %q, missing %q", msg, want)
+		}
+	}
+}
+
+func TestWebhook_DefaultJSON(t *testing.T) {
+	var c capture
+	srv := c.server(t)
+	send(t, config.NotificationConfig{Type: "webhook", URL: srv.URL, Headers: map[string]string{"X-Token": "abc"}}, failedEvent())
+
+	var got struct {
+		Title   string   `json:"title"`
+		Success bool     `json:"success"`
+		Results []Result `json:"results"`
+	}
+	if err := json.Unmarshal(c.body, &got); err != nil {
+		t.Fatalf("body %q: %v", c.body, err)
+	}
+	if got.Title != "Backuparr backup failed: radarr" || len(got.Results) != 2 || got.Success {
+		t.Errorf("payload = %+v", got)
+	}
+	if c.headers.Get("X-Token") != "abc" {
+		t.Error("custom header not sent")
+	}
+}
+
+func TestWebhook_Template(t *testing.T) {
+	var c capture
+	srv := c.server(t)
+	tmpl := `{"text": "{{.Kind}}{{range .Failed}} {{.App}}={{.Error}}{{end}}"}`
+	send(t, config.NotificationConfig{Type: "webhook", URL: srv.URL, Template: tmpl}, failedEvent())
+
+	if string(c.body) != `{"text": "backup radarr=connection refused"}` {
+		t.Errorf("body = %q", c.body)
+	}
+	if c.headers.Get("Content-Type") != "application/json" {
+		t.Errorf("Content-Type = %q, want JSON for a JSON template", c.headers.Get("Content-Type"))
+	}
+}
+
+func TestNtfy(t *testing.T) {
+	var c capture
+	srv := c.server(t)
+	send(t, config.NotificationConfig{Type: "ntfy", URL: srv.URL + "/backups", Token: "tk"}, failedEvent())
+
+	if c.path != "/backups" || c.headers.Get("Title") != "Backuparr backup failed: radarr" {
+		t.Errorf("path %q, title %q", c.path, c.headers.Get("Title"))
+	}
+	if c.headers.Get("Priority") != "4" || c.headers.Get("Authorization") != "Bearer tk" {
+		t.Errorf("headers = %v", c.headers)
+	}
+	if !strings.Contains(string(c.body), "radarr: failed") {
+		t.Errorf("body = %q", c.body)
+	}
+}
+
+func TestGotify(t *testing.T) {
+	var c capture
+	srv := c.server(t)
+	send(t, config.NotificationConfig{Type: "gotify", URL: srv.URL + "/", Token: "app-token"}, failedEvent())
+
+	var got map[string]any
+	json.Unmarshal(c.body, &got)
+	if c.path != "/message" || c.headers.Get("X-Gotify-Key") != "app-token" || got["priority"] != float64(8) {
+		t.Errorf("path %q, headers %v, body %v", c.path, c.headers, got)
+	}
+}
+
+func TestChatWebhooks(t *testing.T) {
+	for typ, field := range map[string]string{"discord": "content", "slack": "text"} {
+		t.Run(typ, func(t *testing.T) {
+			var c capture
+			srv := c.server(t)
+			send(t, config.NotificationConfig{Type: typ, URL: srv.URL}, failedEvent())
+
+			var got map[string]string
+			json.Unmarshal(c.body, &got)
+			if !strings.Contains(got[field], "Backuparr backup failed: radarr") {
+				t.Errorf("%s = %q", field, got[field])
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	var onFailure, always capture
+	failureSrv, alwaysSrv := onFailure.server(t), always.server(t)
+
+	d, err := New([]config.NotificationConfig{
+		{Type: "webhook", URL: failureSrv.URL},
+		{Type: "webhook", URL: alwaysSrv.URL, On: "always"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ok := failedEvent()
+	ok.Success = true
+	d.Send(context.Background(), ok)
+	d.Send(context.Background(), failedEvent())
+
+	if onFailure.calls != 1 || always.calls != 2 {
+		t.Errorf("on-failure target called %d times (want 1), always target %d (want 2)", onFailure.calls, always.calls)
+	}
+}
+
+func TestNew_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.NotificationConfig
+	}{
+		{"unknown type", config.NotificationConfig{Type: "pager"}},
+		{"missing url", config.NotificationConfig{Type: "ntfy"}},
+		{"gotify without token", config.NotificationConfig{Type: "gotify", URL: "http://g"}},
+		{"smtp without recipients", config.NotificationConfig{Type: "smtp", Host: "mail", From: "a@b"}},
+		{"bad filter", config.NotificationConfig{Type: "webhook", URL: "http://x", On: "sometimes"}},
+		{"bad template", config.NotificationConfig{Type: "webhook", URL: "http://x", Template: "{{.Kind"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New([]config.NotificationConfig{tt.cfg}); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+// fakeSMTP accepts one message over plain SMTP and returns what it received.
+func fakeSMTP(t *testing.T) (port int, received <-chan string) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	ch := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		reply := func(s string) { conn.Write([]byte(s + "\r\n")) }
+
+		var transcript strings.Builder
+		reply("220 localhost ESMTP")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			cmd := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(cmd, "MAIL"), strings.HasPrefix(cmd, "RCPT"):
+				transcript.WriteString(line)
+				reply("250 OK")
+			case cmd == "DATA":
+				reply("354 go ahead")
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil || l == ".\r\n" {
+						break
+					}
+					transcript.WriteString(l)
+				}
+				reply("250 queued")
+			case cmd == "QUIT":
+				reply("221 bye")
+				ch <- transcript.String()
+				return
+			default:
+				reply("502 not implemented")
+			}
+		}
+	}()
+	return ln.Addr().(*net.TCPAddr).Port, ch
+}
+
+func TestSMTP(t *testing.T) {
+	port, received := fakeSMTP(t)
+
+	n, err := newSMTP("mail", config.NotificationConfig{
+		Host: "127.0.0.1",
+		Port: port,
+		From: "backuparr@example.com",
+		To:   []string{"ops@example.com"},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := n.Notify(context.Background(), failedEvent()); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	got := <-received
+	for _, want := range []string{
+		"MAIL FROM:<backuparr@example.com>",
+		"RCPT TO:<ops@example.com>",
+		"Subject: Backuparr backup failed: radarr",
+		"radarr: failed: connection refused",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("transcript missing %q:\n%s", want, got)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// Package postgres implements a backup.Client for PostgreSQL databases that
+// are not managed through an *arr config.xml (e.g. Authentik, Immich,
+// Nextcloud). Each configured database is dumped into postgres/ of the
+// archive, in the same layout as enhanced *arr backups, and restored from it.
+package postgres
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"time"
+
+	"backuparr/internal/backup"
+)
+
+// Verify Client satisfies the backup.Client interface at compile time.
+var _ backup.Client = (*Client)(nil)
+
+// Client backs up and restores a fixed list of databases on one server.
+type Client struct {
+	appName   string
+	pg        *backup.PostgresConfig
+	databases []string
+}
+
+// NewClient creates a client that dumps databases from the server in pg.
+// pg.MainDB and pg.LogDB are ignored. Unless the native engine is selected,
+// an empty pg.Format means custom: these databases may hold functions, types
+// and schemas outside public, which only pg_restore --clean can replace.
+func NewClient(appName string, pg *backup.PostgresConfig, databases []string) (*Client, error) {
+	if appName == "" {
+		return nil, fmt.Errorf("app name is required for postgres client")
+	}
+	if pg == nil || pg.Host == "" {
+		return nil, fmt.Errorf("postgres host is required")
+	}
+	if len(databases) == 0 {
+		return nil, fmt.Errorf("at least one database is required")
+	}
+	seen := map[string]string{}
+	for _, db := range databases {
+		if db == "" {
+			return nil, fmt.Errorf("database name is empty")
+		}
+		// Dump file names are sanitized, so two databases must not map to
+		// the same file.
+		name := backup.DumpFileName(db, backup.DumpFormatPlain)
+		if other, ok := seen[name]; ok {
+			return nil, fmt.Errorf("databases %q and %q would share dump file %s", other, db, name)
+		}
+		seen[name] = db
+	}
+	if err := pg.Validate(); err != nil {
+		return nil, err
+	}
+	cfg := *pg
+	if cfg.Format == "" && cfg.Engine != backup.EngineNative {
+		cfg.Format = backup.DumpFormatCustom
+	}
+	return &Client{appName: appName, pg: &cfg, databases: databases}, nil
+}
+
+// Name returns the configured application name.
+func (c *Client) Name() string {
+	return c.appName
+}
+
+// Backup dumps every configured database into a ZIP stream. Dumps are
+// written through a pipe as they are produced, so nothing is buffered in
+// memory.
+func (c *Client) Backup(ctx context.Context) (*backup.BackupResult, io.ReadCloser, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		zw := zip.NewWriter(pw)
+		err := c.pg.WriteDumps(ctx, zw, c.databases)
+		if err == nil {
+			err = zw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	log.Printf("[%s] Dumping %d databases from %s:%s", c.appName, len(c.databases), c.pg.Host, c.pg.Port)
+	return &backup.BackupResult{
+		Name:      fmt.Sprintf("%s-postgres-backup", c.appName),
+		CreatedAt: time.Now(),
+		DBType:    backup.DBTypePostgres,
+	}, pr, nil
+}
+
+// Restore loads each dump in the archive into its configured database,
+// replacing the public schema (plain dumps) or the dumped objects (custom
+// format). Dumps for databases that are not configured are an error, so a
+// backup is never restored into a database the config does not name.
+func (c *Client) Restore(ctx context.Context, backupData io.Reader, opts backup.RestoreOptions) (*backup.RestorePlan, error) {
+	spool, err := backup.Spool(backupData, "backuparr-postgres-*.zip")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup data: %w", err)
+	}
+	defer spool.Close()
+
+	reader, err := zip.NewReader(spool, spool.Size())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open backup zip: %w", err)
+	}
+
+	dumps := backup.PostgresDumpFiles(reader)
+	if len(dumps) == 0 {
+		return nil, fmt.Errorf("backup contains no postgres dumps")
+	}
+
+	targets := make(map[string]string, len(dumps))
+	for filename := range dumps {
+		db, ok := c.databaseFor(filename)
+		if !ok {
+			return nil, fmt.Errorf("dump %s does not match a configured database", filename)
+		}
+		targets[filename] = db
+	}
+
+	plan := &backup.RestorePlan{
+		App:      c.appName,
+		DBType:   backup.DBTypePostgres,
+		Postgres: &backup.PostgresRestorePlan{Host: c.pg.Host, Port: c.pg.Port, User: c.pg.User},
+		Notes:    []string{"restart applications using these databases after the restore"},
+	}
+	for _, db := range targets {
+		plan.Postgres.Databases = append(plan.Postgres.Databases, db)
+	}
+	sort.Strings(plan.Postgres.Databases)
+
+	if opts.DryRun {
+		return plan, nil
+	}
+
+	if err := backup.CheckRestoreToolsZip(dumps); err != nil {
+		return nil, err
+	}
+	for filename, file := range dumps {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		db := targets[filename]
+		log.Printf("[%s] Restoring %s (%d bytes) into %s...", c.appName, filename, file.UncompressedSize64, db)
+		if err := c.restoreDump(ctx, db, file); err != nil {
+			return nil, fmt.Errorf("failed to restore %s: %w", db, err)
+		}
+	}
+	log.Printf("[%s] PostgreSQL databases restored successfully", c.appName)
+	return plan, nil
+}
+
+// restoreDump streams one dump out of the archive into db.
+func (c *Client) restoreDump(ctx context.Context, db string, file *zip.File) error {
+	rc, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", file.Name, err)
+	}
+	defer rc.Close()
+	return c.pg.RestoreDatabaseFrom(ctx, db, rc)
+}
+
+// databaseFor maps a dump file name back to the configured database it was
+// taken from.
+func (c *Client) databaseFor(filename string) (string, bool) {
+	for _, db := range c.databases {
+		if filename == backup.DumpFileName(db, backup.DumpFormatPlain) || filename == backup.DumpFileName(db, backup.DumpFormatCustom) {
+			return db, true
+		}
+	}
+	return "", false
+}
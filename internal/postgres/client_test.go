@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"backuparr/internal/backup"
+)
+
+// fakePgDump installs a pg_dump script on PATH that prints a dump naming
+// the database it was asked for: a custom-format archive (PGDMP header)
+// holding a type and a function when --format=custom is passed, otherwise
+// a plain SQL script.
+func fakePgDump(t *testing.T) {
+	t.Helper()
+	script := "#!/bin/sh\nwhile [ $# -gt 0 ]; do [ \"$1\" = -d ] && db=$2; [ \"$1\" = --format=custom ] && custom=1; shift; done\n" +
+		"if [ -n \"$custom\" ]; then printf 'PGDMP -- dump of %s\\nCREATE TYPE public.mood;\\nCREATE FUNCTION public.cheer();\\n' \"$db\"; exit; fi\n" +
+		"printf -- '-- dump of %s\\n--\\n-- PostgreSQL database dump complete\\n--\\n' \"$db\"\n"
+	installTool(t, "pg_dump", script)
+}
+
+// installTool writes script as an executable named name into a directory
+// prepended to PATH.
+func installTool(t *testing.T, name, script string) {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestNewClient_Validation(t *testing.T) {
+	pg := &backup.PostgresConfig{Host: "db"}
+	tests := map[string]struct {
+		pg  *backup.PostgresConfig
+		dbs []string
+	}{
+		"no host":        {&backup.PostgresConfig{}, []string{"immich"}},
+		"no databases":   {pg, nil},
+		"dump collision": {pg, []string{"immich-db", "immich_db"}},
+		"invalid engine": {&backup.PostgresConfig{Host: "db", Engine: "odbc"}, []string{"immich"}},
+		"empty name":     {pg, []string{""}},
+	}
+	for name, tt := range tests {
+		if _, err := NewClient("immich", tt.pg, tt.dbs); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestBackup(t *testing.T) {
+	fakePgDump(t)
+	client, err := NewClient("authentik", &backup.PostgresConfig{Host: "db", Port: "5432", User: "authentik"}, []string{"authentik", "authentik-audit"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, reader, err := client.Backup(context.Background())
+	if err != nil {
+		t.Fatalf("Backup: %v", err)
+	}
+	data, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		t.Fatalf("read backup: %v", err)
+	}
+	if result.DBType != backup.DBTypePostgres {
+		t.Errorf("DBType = %q", result.DBType)
+	}
+
+	dumps, err := backup.ExtractPostgresDumpsFromZip(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(dumps["authentik_audit.dump"]), "-- dump of authentik-audit") || len(dumps) != 2 {
+		t.Errorf("dumps = %q", dumps)
+	}
+
+	plan, err := client.Restore(context.Background(), bytes.NewReader(data), backup.RestoreOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if plan.Postgres == nil || strings.Join(plan.Postgres.Databases, ",") != "authentik,authentik-audit" {
+		t.Errorf("plan = %+v", plan.Postgres)
+	}
+}
+
+// TestRestore_ReplacesTypesAndFunctions restores a default (custom-format)
+// backup whose schema holds a type and a function. psql would fail on
+// those with "already exists", so the restore must go through pg_restore
+// --clean --if-exists.
+func TestRestore_ReplacesTypesAndFunctions(t *testing.T) {
+	fakePgDump(t)
+	installTool(t, "psql", "#!/bin/sh\necho 'ERROR:  type \"mood\" already exists' >&2\nexit 3\n")
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "pg_restore.log")
+	installTool(t, "pg_restore", "#!/bin/sh\necho \"$@\" >> "+logPath+"\n")
+
+	pg := &backup.PostgresConfig{Host: "db", Port: "5432", User: "immich"}
+	client, err := NewClient("immich", pg, []string{"immich"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pg.Format != "" {
+		t.Errorf("NewClient changed the caller's format to %q", pg.Format)
+	}
+
+	_, reader, err := client.Backup(context.Background())
+	if err != nil {
+		t.Fatalf("Backup: %v", err)
+	}
+	data, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		t.Fatalf("read backup: %v", err)
+	}
+
+	if _, err := client.Restore(context.Background(), bytes.NewReader(data), backup.RestoreOptions{}); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	args, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("pg_restore was not run: %v", err)
+	}
+	for _, want := range []string{"-d immich", "--clean", "--if-exists"} {
+		if !strings.Contains(string(args), want) {
+			t.Errorf("pg_restore args %q missing %q", args, want)
+		}
+	}
+}
+
+func TestNewClient_NativeEngineStaysPlain(t *testing.T) {
+	client, err := NewClient("immich", &backup.PostgresConfig{Host: "db", Engine: backup.EngineNative}, []string{"immich"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.pg.Format != "" {
+		t.Errorf("native engine format = %q, want plain", client.pg.Format)
+	}
+}
+
+func TestRestore_UnknownDatabase(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	fw, _ := zw.Create("postgres/other.sql")
+	fw.Write([]byte("-- dump"))
+	zw.Close()
+
+	client, _ := NewClient("immich", &backup.PostgresConfig{Host: "db"}, []string{"immich"})
+	if _, err := client.Restore(context.Background(), bytes.NewReader(buf.Bytes()), backup.RestoreOptions{DryRun: true}); err == nil {
+		t.Error("dump for an unconfigured database accepted")
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"backuparr/internal/backup"
 )
 
 type mockProwlarr struct {
@@ -48,28 +50,17 @@ func (m *mockProwlarr) handlePostCommand(w http.ResponseWriter, r *http.Request)
 		fmt.Fprint(w, `{"message":"command failed"}`)
 		return
 	}
-	id := int32(1)
-	name := "Backup"
-	status := Queued
-	resp := CommandResource{Id: &id, Name: &name, Status: &status}
+	resp := map[string]any{"id": 1, "name": "Backup", "status": "queued"}
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(resp)
 }
 
 func (m *mockProwlarr) handleGetCommand(w http.ResponseWriter, r *http.Request) {
-	var status CommandStatus
-	if m.commandStatusFail {
-		status = Failed
-	} else {
-		status = Completed
-	}
-	id := int32(1)
-	name := "Backup"
-	msg := "done"
+	status, msg := "completed", "done"
 	if m.commandStatusFail {
-		msg = "Backup command failed"
+		status, msg = "failed", "Backup command failed"
 	}
-	resp := CommandResource{Id: &id, Name: &name, Status: &status, Message: &msg}
+	resp := map[string]any{"id": 1, "name": "Backup", "status": status, "message": msg}
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(resp)
 }
@@ -77,15 +68,16 @@ func (m *mockProwlarr) handleGetCommand(w http.ResponseWriter, r *http.Request)
 func (m *mockProwlarr) handleListBackups(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	if m.noBackups {
-		json.NewEncoder(w).Encode([]BackupResource{})
+		json.NewEncoder(w).Encode([]map[string]any{})
 		return
 	}
-	id := int32(1)
-	name := "prowlarr_backup_2025-02-09.zip"
-	path := "/api/v1/system/backup/download/1"
-	size := int64(1024)
-	ts := time.Date(2025, 2, 9, 12, 0, 0, 0, time.UTC)
-	json.NewEncoder(w).Encode([]BackupResource{{Id: &id, Name: &name, Path: &path, Size: &size, Time: &ts}})
+	json.NewEncoder(w).Encode([]map[string]any{{
+		"id":   1,
+		"name": "prowlarr_backup_2025-02-09.zip",
+		"path": "/api/v1/system/backup/download/1",
+		"size": 1024,
+		"time": time.Date(2025, 2, 9, 12, 0, 0, 0, time.UTC),
+	}})
 }
 
 func (m *mockProwlarr) handleDownloadBackup(w http.ResponseWriter, r *http.Request) {
@@ -104,8 +96,7 @@ func (m *mockProwlarr) handleDownloadBackup(w http.ResponseWriter, r *http.Reque
 }
 
 func (m *mockProwlarr) handleConfigHost(w http.ResponseWriter, r *http.Request) {
-	authType := AuthenticationType(m.authMethod)
-	config := HostConfigResource{AuthenticationMethod: &authType}
+	config := map[string]any{"authenticationMethod": m.authMethod}
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(config)
 }
@@ -153,7 +144,7 @@ func makeZip() []byte {
 func TestName(t *testing.T) {
 	mock := newMockProwlarr()
 	defer mock.close()
-	client, err := NewProwlarrClient(mock.server.URL, "test-api-key", "", "")
+	client, err := NewProwlarrClient(mock.server.URL, "test-api-key", "", "", nil)
 	if err != nil {
 		t.Fatalf("create client: %v", err)
 	}
@@ -165,7 +156,7 @@ func TestName(t *testing.T) {
 func TestBackup(t *testing.T) {
 	mock := newMockProwlarr()
 	defer mock.close()
-	client, err := NewProwlarrClient(mock.server.URL, "test-api-key", "", "")
+	client, err := NewProwlarrClient(mock.server.URL, "test-api-key", "", "", nil)
 	if err != nil {
 		t.Fatalf("create client: %v", err)
 	}
@@ -203,7 +194,7 @@ func TestBackup_CommandFail(t *testing.T) {
 	mock := newMockProwlarr()
 	mock.backupCommandFail = true
 	defer mock.close()
-	client, _ := NewProwlarrClient(mock.server.URL, "k", "", "")
+	client, _ := NewProwlarrClient(mock.server.URL, "k", "", "", nil)
 	_, _, err := client.Backup(context.Background())
 	if err == nil {
 		t.Fatal("expected error")
@@ -217,7 +208,7 @@ func TestBackup_CommandStatusFail(t *testing.T) {
 	mock := newMockProwlarr()
 	mock.commandStatusFail = true
 	defer mock.close()
-	client, _ := NewProwlarrClient(mock.server.URL, "k", "", "")
+	client, _ := NewProwlarrClient(mock.server.URL, "k", "", "", nil)
 	_, _, err := client.Backup(context.Background())
 	if err == nil {
 		t.Fatal("expected error")
@@ -231,7 +222,7 @@ func TestBackup_NoBackups(t *testing.T) {
 	mock := newMockProwlarr()
 	mock.noBackups = true
 	defer mock.close()
-	client, _ := NewProwlarrClient(mock.server.URL, "k", "", "")
+	client, _ := NewProwlarrClient(mock.server.URL, "k", "", "", nil)
 	_, _, err := client.Backup(context.Background())
 	if err == nil {
 		t.Fatal("expected error")
@@ -245,7 +236,7 @@ func TestBackup_DownloadFail(t *testing.T) {
 	mock := newMockProwlarr()
 	mock.downloadFail = true
 	defer mock.close()
-	client, _ := NewProwlarrClient(mock.server.URL, "k", "", "")
+	client, _ := NewProwlarrClient(mock.server.URL, "k", "", "", nil)
 	_, _, err := client.Backup(context.Background())
 	if err == nil {
 		t.Fatal("expected error")
@@ -258,8 +249,8 @@ func TestBackup_DownloadFail(t *testing.T) {
 func TestRestore(t *testing.T) {
 	mock := newMockProwlarr()
 	defer mock.close()
-	client, _ := NewProwlarrClient(mock.server.URL, "k", "", "")
-	err := client.Restore(context.Background(), bytes.NewReader(makeZip()))
+	client, _ := NewProwlarrClient(mock.server.URL, "k", "", "", nil)
+	_, err := client.Restore(context.Background(), bytes.NewReader(makeZip()), backup.RestoreOptions{})
 	if err != nil {
 		t.Fatalf("Restore: %v", err)
 	}
@@ -269,8 +260,8 @@ func TestRestore_Fail(t *testing.T) {
 	mock := newMockProwlarr()
 	mock.restoreFail = true
 	defer mock.close()
-	client, _ := NewProwlarrClient(mock.server.URL, "k", "", "")
-	err := client.Restore(context.Background(), bytes.NewReader(makeZip()))
+	client, _ := NewProwlarrClient(mock.server.URL, "k", "", "", nil)
+	_, err := client.Restore(context.Background(), bytes.NewReader(makeZip()), backup.RestoreOptions{})
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -293,8 +284,8 @@ func TestRestore_WithRestart(t *testing.T) {
 	server := httptest.NewServer(mux)
 	defer server.Close()
 
-	client, _ := NewProwlarrClient(server.URL, "k", "", "")
-	err := client.Restore(context.Background(), bytes.NewReader(makeZip()))
+	client, _ := NewProwlarrClient(server.URL, "k", "", "", nil)
+	_, err := client.Restore(context.Background(), bytes.NewReader(makeZip()), backup.RestoreOptions{})
 	if err != nil {
 		t.Fatalf("Restore: %v", err)
 	}
@@ -306,25 +297,19 @@ func TestRestore_WithRestart(t *testing.T) {
 func TestBackup_ContextCancellation(t *testing.T) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("POST /api/v1/command", func(w http.ResponseWriter, r *http.Request) {
-		id := int32(1)
-		name := "Backup"
-		status := Queued
-		resp := CommandResource{Id: &id, Name: &name, Status: &status}
+		resp := map[string]any{"id": 1, "name": "Backup", "status": "queued"}
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(resp)
 	})
 	mux.HandleFunc("GET /api/v1/command/{id}", func(w http.ResponseWriter, r *http.Request) {
-		id := int32(1)
-		name := "Backup"
-		status := Started
-		resp := CommandResource{Id: &id, Name: &name, Status: &status}
+		resp := map[string]any{"id": 1, "name": "Backup", "status": "started"}
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(resp)
 	})
 	server := httptest.NewServer(mux)
 	defer server.Close()
 
-	client, _ := NewProwlarrClient(server.URL, "k", "", "")
+	client, _ := NewProwlarrClient(server.URL, "k", "", "", nil)
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 	_, _, err := client.Backup(ctx)
@@ -337,18 +322,15 @@ func TestBackup_ContextCancellation(t *testing.T) {
 }
 
 func TestNewProwlarrClient(t *testing.T) {
-	client, err := NewProwlarrClient("http://localhost:99999", "test-key", "user", "pass")
+	client, err := NewProwlarrClient("http://localhost:99999", "test-key", "user", "pass", nil)
 	if err != nil {
 		t.Fatalf("create: %v", err)
 	}
 	if client == nil {
 		t.Fatal("nil client")
 	}
-	if client.apiKey != "test-key" {
-		t.Errorf("apiKey = %q", client.apiKey)
-	}
-	if client.username != "user" {
-		t.Errorf("username = %q", client.username)
+	if client.Name() != "prowlarr" {
+		t.Errorf("Name() = %q", client.Name())
 	}
 }
 
@@ -357,33 +339,27 @@ func TestFormsLogin_BadCredentials(t *testing.T) {
 	// (this is what the *arr apps do on failed login)
 	mux := http.NewServeMux()
 	mux.HandleFunc("POST /api/v1/command", func(w http.ResponseWriter, r *http.Request) {
-		id := int32(1)
-		name := "Backup"
-		status := Completed
-		resp := CommandResource{Id: &id, Name: &name, Status: &status}
+		resp := map[string]any{"id": 1, "name": "Backup", "status": "completed"}
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(resp)
 	})
 	mux.HandleFunc("GET /api/v1/command/{id}", func(w http.ResponseWriter, r *http.Request) {
-		id := int32(1)
-		name := "Backup"
-		status := Completed
-		resp := CommandResource{Id: &id, Name: &name, Status: &status}
+		resp := map[string]any{"id": 1, "name": "Backup", "status": "completed"}
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(resp)
 	})
 	mux.HandleFunc("GET /api/v1/system/backup", func(w http.ResponseWriter, r *http.Request) {
-		id := int32(1)
-		name := "prowlarr_backup.zip"
-		path := "/backup/manual/prowlarr_backup.zip"
-		size := int64(1024)
-		ts := time.Date(2025, 2, 9, 12, 0, 0, 0, time.UTC)
 		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode([]BackupResource{{Id: &id, Name: &name, Path: &path, Size: &size, Time: &ts}})
+		json.NewEncoder(w).Encode([]map[string]any{{
+			"id":   1,
+			"name": "prowlarr_backup.zip",
+			"path": "/backup/manual/prowlarr_backup.zip",
+			"size": 1024,
+			"time": time.Date(2025, 2, 9, 12, 0, 0, 0, time.UTC),
+		}})
 	})
 	mux.HandleFunc("GET /api/v1/config/host", func(w http.ResponseWriter, r *http.Request) {
-		authType := AuthenticationType("forms")
-		config := HostConfigResource{AuthenticationMethod: &authType}
+		config := map[string]any{"authenticationMethod": "forms"}
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(config)
 	})
@@ -395,7 +371,7 @@ func TestFormsLogin_BadCredentials(t *testing.T) {
 	server := httptest.NewServer(mux)
 	defer server.Close()
 
-	client, _ := NewProwlarrClient(server.URL, "k", "wrong-user", "wrong-pass")
+	client, _ := NewProwlarrClient(server.URL, "k", "wrong-user", "wrong-pass", nil)
 	_, _, err := client.Backup(context.Background())
 	if err == nil {
 		t.Fatal("expected error for bad credentials")
@@ -409,33 +385,27 @@ func TestFormsLogin_Success(t *testing.T) {
 	// Mock a server that returns 302 and sets the ProwlarrAuth cookie on login
 	mux := http.NewServeMux()
 	mux.HandleFunc("POST /api/v1/command", func(w http.ResponseWriter, r *http.Request) {
-		id := int32(1)
-		name := "Backup"
-		status := Completed
-		resp := CommandResource{Id: &id, Name: &name, Status: &status}
+		resp := map[string]any{"id": 1, "name": "Backup", "status": "completed"}
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(resp)
 	})
 	mux.HandleFunc("GET /api/v1/command/{id}", func(w http.ResponseWriter, r *http.Request) {
-		id := int32(1)
-		name := "Backup"
-		status := Completed
-		resp := CommandResource{Id: &id, Name: &name, Status: &status}
+		resp := map[string]any{"id": 1, "name": "Backup", "status": "completed"}
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(resp)
 	})
 	mux.HandleFunc("GET /api/v1/system/backup", func(w http.ResponseWriter, r *http.Request) {
-		id := int32(1)
-		name := "prowlarr_backup.zip"
-		path := "/backup/manual/prowlarr_backup.zip"
-		size := int64(1024)
-		ts := time.Date(2025, 2, 9, 12, 0, 0, 0, time.UTC)
 		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode([]BackupResource{{Id: &id, Name: &name, Path: &path, Size: &size, Time: &ts}})
+		json.NewEncoder(w).Encode([]map[string]any{{
+			"id":   1,
+			"name": "prowlarr_backup.zip",
+			"path": "/backup/manual/prowlarr_backup.zip",
+			"size": 1024,
+			"time": time.Date(2025, 2, 9, 12, 0, 0, 0, time.UTC),
+		}})
 	})
 	mux.HandleFunc("GET /api/v1/config/host", func(w http.ResponseWriter, r *http.Request) {
-		authType := AuthenticationType("forms")
-		config := HostConfigResource{AuthenticationMethod: &authType}
+		config := map[string]any{"authenticationMethod": "forms"}
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(config)
 	})
@@ -459,7 +429,7 @@ func TestFormsLogin_Success(t *testing.T) {
 	server := httptest.NewServer(mux)
 	defer server.Close()
 
-	client, _ := NewProwlarrClient(server.URL, "k", "admin", "password")
+	client, _ := NewProwlarrClient(server.URL, "k", "admin", "password", nil)
 	result, reader, err := client.Backup(context.Background())
 	if err != nil {
 		t.Fatalf("Backup with forms login should succeed: %v", err)
@@ -469,3 +439,41 @@ func TestFormsLogin_Success(t *testing.T) {
 		t.Error("result.Name is empty")
 	}
 }
+
+func TestRestore_PostgresDryRun(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range []struct{ name, content string }{
+		{"config.xml", "<Config><PostgresHost>pg</PostgresHost><PostgresPort>5432</PostgresPort><PostgresUser>prowlarr</PostgresUser>" +
+			"<PostgresMainDb>prowlarr-main</PostgresMainDb><PostgresLogDb>prowlarr-log</PostgresLogDb></Config>"},
+		{"postgres/prowlarr_main.sql", "-- dump"},
+		{"postgres/prowlarr_log.sql", "-- dump"},
+	} {
+		fw, _ := zw.Create(f.name)
+		fw.Write([]byte(f.content))
+	}
+	zw.Close()
+
+	mock := newMockProwlarr()
+	defer mock.close()
+	client, _ := NewProwlarrClient(mock.server.URL, "k", "", "", &backup.PostgresConfig{Host: "db.lan"})
+	plan, err := client.Restore(context.Background(), bytes.NewReader(buf.Bytes()), backup.RestoreOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if plan.DBType != backup.DBTypePostgres || plan.Postgres == nil {
+		t.Fatalf("plan = %+v, want a postgres restore", plan)
+	}
+	if plan.Postgres.Host != "db.lan" || strings.Join(plan.Postgres.Databases, ",") != "prowlarr-log,prowlarr-main" {
+		t.Errorf("postgres plan = %+v", plan.Postgres)
+	}
+
+	staging := &backup.PostgresConfig{MainDB: "prowlarr-main-staging", LogDB: "prowlarr-log-staging"}
+	plan, err = client.Restore(context.Background(), bytes.NewReader(buf.Bytes()), backup.RestoreOptions{DryRun: true, Postgres: staging})
+	if err != nil {
+		t.Fatalf("retargeted Restore: %v", err)
+	}
+	if got := strings.Join(plan.Postgres.Databases, ","); got != "prowlarr-log-staging,prowlarr-main-staging" {
+		t.Errorf("retargeted databases = %s", got)
+	}
+}
@@ -0,0 +1,28 @@
+package readarr
+
+import (
+	"fmt"
+
+	"backuparr/internal/arr"
+	"backuparr/internal/backup"
+)
+
+// Ensure ReadarrClient implements backup.Client
+var _ backup.Client = (*ReadarrClient)(nil)
+
+// app describes Readarr to the shared *arr client: the /api/v1 endpoints, with PostgreSQL support.
+var app = arr.App{Name: "readarr", APIVersion: "v1", Postgres: true}
+
+// ReadarrClient backs up and restores Readarr through the shared *arr client.
+type ReadarrClient struct {
+	*arr.Client
+}
+
+// NewReadarrClient creates a new Readarr API client with API key authentication
+func NewReadarrClient(baseURL, apiKey, username, password string, pgOverride *backup.PostgresConfig) (*ReadarrClient, error) {
+	client, err := arr.New(app, baseURL, apiKey, username, password, pgOverride)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create readarr client: %w", err)
+	}
+	return &ReadarrClient{Client: client}, nil
+}
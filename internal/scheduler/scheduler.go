@@ -0,0 +1,209 @@
+// Package scheduler runs per-app backups on cron schedules.
+//
+// Each app with a `schedule:` expression gets its own timer loop. Fire times
+// are offset by a random jitter so several apps sharing the same expression
+// do not all hit their servers (or the same storage bucket) at once. On
+// startup the scheduler can catch up on runs that were missed while the
+// process was down by comparing the most recent scheduled fire time against
+// the newest backup found in storage.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// RunFunc performs a backup for the named app. It should block until the
+// backup has finished so that runs for the same app never overlap.
+type RunFunc func(ctx context.Context, app string)
+
+// LastRunFunc returns the time of the most recent successful backup for the
+// named app, or the zero time if none exists. It is used for catch-up.
+type LastRunFunc func(ctx context.Context, app string) (time.Time, error)
+
+// Options configures a Scheduler.
+type Options struct {
+	// Jitter is the upper bound of a random delay added to each fire time.
+	Jitter time.Duration
+	// CatchUp runs an app immediately on startup if a scheduled run was
+	// missed since its last backup.
+	CatchUp bool
+	// LastRun is consulted for catch-up. Required when CatchUp is true.
+	LastRun LastRunFunc
+}
+
+// Entry is the public view of a scheduled app.
+type Entry struct {
+	App      string
+	Spec     string
+	NextRun  time.Time
+	LastRun  time.Time
+	Running  bool
+	Schedule cron.Schedule
+}
+
+// Scheduler fires RunFunc for each registered app on its cron schedule.
+type Scheduler struct {
+	opts    Options
+	run     RunFunc
+	mu      sync.RWMutex
+	entries map[string]*Entry
+	wg      sync.WaitGroup
+}
+
+// Parse validates a cron expression. Standard 5-field expressions and
+// descriptors such as "@daily" or "@every 6h" are accepted, optionally
+// prefixed with "CRON_TZ=<zone>".
+func Parse(spec string) (cron.Schedule, error) {
+	sched, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %w", spec, err)
+	}
+	return sched, nil
+}
+
+// New creates a Scheduler that calls run for each due app.
+func New(opts Options, run RunFunc) *Scheduler {
+	return &Scheduler{
+		opts:    opts,
+		run:     run,
+		entries: map[string]*Entry{},
+	}
+}
+
+// Add registers an app with the given cron expression.
+func (s *Scheduler) Add(app, spec string) error {
+	sched, err := Parse(spec)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entries[app]; ok {
+		return fmt.Errorf("app %q is already scheduled", app)
+	}
+	s.entries[app] = &Entry{App: app, Spec: spec, Schedule: sched}
+	return nil
+}
+
+// Entries returns a snapshot of all scheduled apps, sorted by name.
+func (s *Scheduler) Entries() []Entry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := make([]Entry, 0, len(s.entries))
+	for _, e := range s.entries {
+		out = append(out, *e)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].App < out[j].App
+	})
+	return out
+}
+
+// Lookup returns the entry for the named app.
+func (s *Scheduler) Lookup(app string) (Entry, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	e, ok := s.entries[app]
+	if !ok {
+		return Entry{}, false
+	}
+	return *e, true
+}
+
+// Start launches one goroutine per scheduled app. The goroutines exit when
+// ctx is cancelled; a run in progress is cancelled through ctx too.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, e := range s.Entries() {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.loop(ctx, e.App, e.Schedule)
+		}()
+	}
+}
+
+// Wait blocks until every goroutine launched by Start has exited, including
+// any run in progress, after the context passed to Start is cancelled.
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, app string, sched cron.Schedule) {
+	if s.opts.CatchUp && s.opts.LastRun != nil {
+		last, err := s.opts.LastRun(ctx, app)
+		if err != nil {
+			log.Printf("[scheduler] [%s] Could not determine last backup, skipping catch-up: %v", app, err)
+		} else if Missed(sched, last, time.Now()) {
+			if last.IsZero() {
+				log.Printf("[scheduler] [%s] No previous backup found, running now", app)
+			} else {
+				log.Printf("[scheduler] [%s] Missed run since %s, running now", app, last.Format(time.RFC3339))
+			}
+			s.fire(ctx, app)
+		}
+	}
+
+	for {
+		next := NextRun(sched, time.Now(), s.opts.Jitter)
+		s.update(app, func(e *Entry) { e.NextRun = next })
+		log.Printf("[scheduler] [%s] Next run at %s", app, next.Format(time.RFC3339))
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.fire(ctx, app)
+	}
+}
+
+func (s *Scheduler) fire(ctx context.Context, app string) {
+	s.update(app, func(e *Entry) {
+		e.Running = true
+		e.LastRun = time.Now()
+	})
+	defer s.update(app, func(e *Entry) { e.Running = false })
+
+	s.run(ctx, app)
+}
+
+func (s *Scheduler) update(app string, fn func(e *Entry)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.entries[app]; ok {
+		fn(e)
+	}
+}
+
+// NextRun returns the next fire time after t, delayed by a random duration
+// in [0, jitter).
+func NextRun(sched cron.Schedule, t time.Time, jitter time.Duration) time.Time {
+	next := sched.Next(t)
+	if jitter > 0 {
+		next = next.Add(rand.N(jitter))
+	}
+	return next
+}
+
+// Missed reports whether a scheduled fire time occurred between lastRun and
+// now. A zero lastRun (no backup has ever been taken) always counts as missed.
+func Missed(sched cron.Schedule, lastRun, now time.Time) bool {
+	if lastRun.IsZero() {
+		return true
+	}
+	next := sched.Next(lastRun)
+	return !next.IsZero() && !next.After(now)
+}
@@ -0,0 +1,193 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+func mustParse(t *testing.T, spec string) cron.Schedule {
+	t.Helper()
+	sched, err := Parse(spec)
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", spec, err)
+	}
+	return sched
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		spec    string
+		wantErr bool
+	}{
+		{"0 3 * * *", false},
+		{"@daily", false},
+		{"@every 6h", false},
+		{"CRON_TZ=Europe/Berlin 30 2 * * 1", false},
+		{"", true},
+		{"not a cron", true},
+		{"0 3 * *", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			_, err := Parse(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNextRun_NoJitter(t *testing.T) {
+	sched := mustParse(t, "0 3 * * *")
+	now := time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC)
+
+	got := NextRun(sched, now, 0)
+	want := time.Date(2026, 2, 7, 3, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("NextRun = %v, want %v", got, want)
+	}
+}
+
+func TestNextRun_Jitter(t *testing.T) {
+	sched := mustParse(t, "0 3 * * *")
+	now := time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC)
+	base := time.Date(2026, 2, 7, 3, 0, 0, 0, time.UTC)
+	jitter := 10 * time.Minute
+
+	for i := 0; i < 100; i++ {
+		got := NextRun(sched, now, jitter)
+		if got.Before(base) || !got.Before(base.Add(jitter)) {
+			t.Fatalf("NextRun = %v, want within [%v, %v)", got, base, base.Add(jitter))
+		}
+	}
+}
+
+func TestMissed(t *testing.T) {
+	sched := mustParse(t, "0 3 * * *")
+	now := time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		lastRun time.Time
+		want    bool
+	}{
+		{"never backed up", time.Time{}, true},
+		{"backed up after today's run", time.Date(2026, 2, 6, 3, 5, 0, 0, time.UTC), false},
+		{"backed up before today's run", time.Date(2026, 2, 5, 22, 0, 0, 0, time.UTC), true},
+		{"several days behind", time.Date(2026, 2, 1, 3, 0, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Missed(sched, tt.lastRun, now); got != tt.want {
+				t.Errorf("Missed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdd_Validation(t *testing.T) {
+	s := New(Options{}, func(context.Context, string) {})
+
+	if err := s.Add("sonarr", "0 3 * * *"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := s.Add("sonarr", "0 4 * * *"); err == nil {
+		t.Error("expected error for duplicate app")
+	}
+	if err := s.Add("radarr", "bogus"); err == nil {
+		t.Error("expected error for invalid schedule")
+	}
+
+	entries := s.Entries()
+	if len(entries) != 1 || entries[0].App != "sonarr" || entries[0].Spec != "0 3 * * *" {
+		t.Errorf("Entries() = %+v, want single sonarr entry", entries)
+	}
+}
+
+func TestStart_CatchUp(t *testing.T) {
+	var mu sync.Mutex
+	var ran []string
+	done := make(chan struct{}, 2)
+
+	s := New(Options{
+		CatchUp: true,
+		LastRun: func(ctx context.Context, app string) (time.Time, error) {
+			if app == "sonarr" {
+				// Far in the past: a daily run was missed.
+				return time.Now().Add(-72 * time.Hour), nil
+			}
+			// Just backed up: nothing missed.
+			return time.Now(), nil
+		},
+	}, func(ctx context.Context, app string) {
+		mu.Lock()
+		ran = append(ran, app)
+		mu.Unlock()
+		done <- struct{}{}
+	})
+
+	if err := s.Add("sonarr", "@daily"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Add("radarr", "@daily"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("catch-up run did not happen")
+	}
+
+	// Give radarr's loop a moment to (incorrectly) fire if it were going to.
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if e, _ := s.Lookup("radarr"); !e.NextRun.IsZero() {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(ran) != 1 || ran[0] != "sonarr" {
+		t.Errorf("ran = %v, want [sonarr]", ran)
+	}
+
+	e, ok := s.Lookup("sonarr")
+	if !ok || e.LastRun.IsZero() {
+		t.Errorf("sonarr entry = %+v, want LastRun set", e)
+	}
+}
+
+func TestWait_CancelsRunningRun(t *testing.T) {
+	started := make(chan struct{})
+	cancelled := false
+	s := New(Options{CatchUp: true, LastRun: func(ctx context.Context, app string) (time.Time, error) {
+		return time.Time{}, nil
+	}}, func(ctx context.Context, app string) {
+		close(started)
+		<-ctx.Done()
+		cancelled = true
+	})
+	if err := s.Add("sonarr", "@daily"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.Start(ctx)
+	<-started
+	cancel()
+	s.Wait()
+	if !cancelled {
+		t.Error("Wait returned before the running run saw the cancellation")
+	}
+}
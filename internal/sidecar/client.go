@@ -5,7 +5,6 @@
 package sidecar
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -44,13 +43,15 @@ func NewClient(baseURL, apiKey, appName string) (*Client, error) {
 			Timeout:   2 * time.Minute,
 			Transport: backup.NewRetryTransport(nil),
 		},
+		// Backups and restores are streamed, so their duration depends on
+		// archive size and the speed of the storage backends on the other
+		// end. Instead of an overall timeout, bound only how long the
+		// sidecar may take to start responding.
 		backupClient: &http.Client{
-			Timeout:   5 * time.Minute,
-			Transport: backup.NewRetryTransport(nil),
+			Transport: backup.NewRetryTransport(streamingTransport()),
 		},
 		uploadClient: &http.Client{
-			Timeout:   5 * time.Minute,
-			Transport: backup.NewRetryTransport(nil),
+			Transport: backup.NewRetryTransport(streamingTransport()),
 		},
 	}, nil
 }
@@ -60,7 +61,15 @@ func (c *Client) Name() string {
 	return c.appName
 }
 
-// Backup triggers a backup on the sidecar and returns the ZIP data.
+// streamingTransport returns a transport for long-running streamed transfers.
+func streamingTransport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.ResponseHeaderTimeout = 5 * time.Minute
+	return t
+}
+
+// Backup triggers a backup on the sidecar and returns the ZIP stream.
+// The response body is returned directly; nothing is buffered in memory.
 func (c *Client) Backup(ctx context.Context) (*backup.BackupResult, io.ReadCloser, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/v1/backup", nil)
 	if err != nil {
@@ -79,64 +88,81 @@ func (c *Client) Backup(ctx context.Context) (*backup.BackupResult, io.ReadClose
 		return nil, nil, fmt.Errorf("backup failed (HTTP %d): %s", resp.StatusCode, body)
 	}
 
-	data, err := io.ReadAll(resp.Body)
-	resp.Body.Close()
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to read backup response: %w", err)
+	// ContentLength is -1 when the sidecar streams with chunked encoding
+	var size int64
+	if resp.ContentLength > 0 {
+		size = resp.ContentLength
 	}
 
 	result := &backup.BackupResult{
 		Name:      fmt.Sprintf("%s-sidecar-backup", c.appName),
-		Size:      int64(len(data)),
+		Size:      size,
 		CreatedAt: time.Now(),
 	}
 
-	log.Printf("[%s] Sidecar backup received: %d bytes", c.appName, len(data))
-	return result, io.NopCloser(bytes.NewReader(data)), nil
+	log.Printf("[%s] Sidecar backup stream started", c.appName)
+	return result, resp.Body, nil
 }
 
-// Restore uploads a backup ZIP to the sidecar for extraction.
-func (c *Client) Restore(ctx context.Context, backupData io.Reader) error {
-	data, err := io.ReadAll(backupData)
-	if err != nil {
-		return fmt.Errorf("failed to read backup data: %w", err)
+// Restore uploads a backup ZIP to the sidecar for extraction. The multipart
+// body is streamed from backupData rather than assembled in memory. With
+// opts.DryRun the sidecar only reports which files it would create or
+// overwrite.
+func (c *Client) Restore(ctx context.Context, backupData io.Reader, opts backup.RestoreOptions) (*backup.RestorePlan, error) {
+	// A sidecar that predates dry runs ignores ?dryRun=true and would
+	// extract the backup and restart the app.
+	if opts.DryRun {
+		if err := c.checkDryRun(ctx); err != nil {
+			return nil, err
+		}
 	}
 
-	var body bytes.Buffer
-	writer := multipart.NewWriter(&body)
+	body, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
 
-	part, err := writer.CreateFormFile("backup", "backup.zip")
-	if err != nil {
-		return fmt.Errorf("failed to create form file: %w", err)
-	}
-	if _, err := io.Copy(part, bytes.NewReader(data)); err != nil {
-		return fmt.Errorf("failed to write form data: %w", err)
-	}
-	writer.Close()
+	go func() {
+		part, err := writer.CreateFormFile("backup", "backup.zip")
+		if err != nil {
+			pw.CloseWithError(fmt.Errorf("failed to create form file: %w", err))
+			return
+		}
+		if _, err := io.Copy(part, backupData); err != nil {
+			pw.CloseWithError(fmt.Errorf("failed to write form data: %w", err))
+			return
+		}
+		pw.CloseWithError(writer.Close())
+	}()
+	defer body.Close()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/v1/restore", &body)
+	reqURL := c.baseURL + "/api/v1/restore"
+	if opts.DryRun {
+		reqURL += "?dryRun=true"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, body)
 	if err != nil {
-		return fmt.Errorf("failed to create restore request: %w", err)
+		return nil, fmt.Errorf("failed to create restore request: %w", err)
 	}
 	req.Header.Set("Content-Type", writer.FormDataContentType())
 	c.setHeaders(req)
 
 	resp, err := c.uploadClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("restore upload failed: %w", err)
+		return nil, fmt.Errorf("restore upload failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, _ := io.ReadAll(resp.Body)
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("restore failed (HTTP %d): %s", resp.StatusCode, respBody)
+		return nil, fmt.Errorf("restore failed (HTTP %d): %s", resp.StatusCode, respBody)
 	}
 
 	var result struct {
-		Success       bool   `json:"success"`
-		FilesRestored int    `json:"filesRestored"`
-		Message       string `json:"message"`
+		Success       bool                `json:"success"`
+		DryRun        bool                `json:"dryRun"`
+		FilesRestored int                 `json:"filesRestored"`
+		Files         []backup.FileChange `json:"files"`
+		Message       string              `json:"message"`
 		Restart       struct {
 			Attempted bool   `json:"attempted"`
 			Success   bool   `json:"success"`
@@ -144,13 +170,54 @@ func (c *Client) Restore(ctx context.Context, backupData io.Reader) error {
 			Error     string `json:"error"`
 		} `json:"restart"`
 	}
-	if err := json.Unmarshal(respBody, &result); err == nil {
+	plan := &backup.RestorePlan{App: c.appName}
+	err = json.Unmarshal(respBody, &result)
+	if opts.DryRun && (err != nil || !result.DryRun) {
+		return nil, fmt.Errorf("sidecar did not confirm the dry run; it may have restored the backup: %s", respBody)
+	}
+	if err == nil {
 		log.Printf("[%s] %s", c.appName, result.Message)
 		if result.Restart.Attempted && !result.Restart.Success {
 			log.Printf("[%s] Warning: restart failed — please restart the app manually", c.appName)
 		}
+		plan.Files = result.Files
+		plan.RestartMethod = result.Restart.Method
+		plan.Restart = result.Restart.Method != ""
+		if result.Message != "" {
+			plan.Notes = append(plan.Notes, result.Message)
+		}
 	}
 
+	return plan, nil
+}
+
+// checkDryRun fails unless the sidecar's health endpoint advertises
+// support for restore dry runs.
+func (c *Client) checkDryRun(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/api/v1/health", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create health request: %w", err)
+	}
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sidecar health check failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("sidecar health check failed (HTTP %d)", resp.StatusCode)
+	}
+
+	var health struct {
+		DryRun bool `json:"dryRun"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&health); err != nil {
+		return fmt.Errorf("failed to decode sidecar health: %w", err)
+	}
+	if !health.DryRun {
+		return fmt.Errorf("sidecar does not support restore dry runs; upgrade it to preview a restore")
+	}
 	return nil
 }
 
@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+
+	"backuparr/internal/backup"
 )
 
 func TestName(t *testing.T) {
@@ -174,7 +176,7 @@ func TestRestore_Success(t *testing.T) {
 	w.Write([]byte("<config/>"))
 	zw.Close()
 
-	err := c.Restore(context.Background(), &zipBuf)
+	_, err := c.Restore(context.Background(), &zipBuf, backup.RestoreOptions{})
 	if err != nil {
 		t.Fatalf("Restore: %v", err)
 	}
@@ -185,6 +187,85 @@ func TestRestore_Success(t *testing.T) {
 	}
 }
 
+func TestRestore_DryRun(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/health" {
+			json.NewEncoder(w).Encode(map[string]any{"status": "ok", "dryRun": true})
+			return
+		}
+		if r.URL.Query().Get("dryRun") != "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]any{"success": false, "error": "expected dry run"})
+			return
+		}
+		io.Copy(io.Discard, r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"dryRun":  true,
+			"files": []map[string]any{
+				{"path": "config.xml", "action": "overwrite", "size": 9},
+				{"path": "app.db", "action": "create", "size": 4096},
+			},
+			"restart": map[string]any{"method": "docker"},
+			"message": "Would restore 2 files",
+		})
+	}))
+	defer srv.Close()
+
+	c, _ := NewClient(srv.URL, "", "testapp")
+	plan, err := c.Restore(context.Background(), bytes.NewReader([]byte("fake-zip")), backup.RestoreOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if len(plan.Files) != 2 || plan.Files[0].Action != backup.FileOverwrite || plan.Files[1].Action != backup.FileCreate {
+		t.Errorf("Files = %+v", plan.Files)
+	}
+	if !plan.Restart || plan.RestartMethod != "docker" {
+		t.Errorf("plan restart = %v/%q, want docker", plan.Restart, plan.RestartMethod)
+	}
+}
+
+func TestRestore_DryRunUnsupported(t *testing.T) {
+	restoreCalled := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/health" {
+			// A sidecar from before dry runs were supported.
+			json.NewEncoder(w).Encode(map[string]any{"status": "ok"})
+			return
+		}
+		restoreCalled = true
+		io.Copy(io.Discard, r.Body)
+		json.NewEncoder(w).Encode(map[string]any{"success": true, "message": "Restored 2 files"})
+	}))
+	defer srv.Close()
+
+	c, _ := NewClient(srv.URL, "", "testapp")
+	if _, err := c.Restore(context.Background(), bytes.NewReader([]byte("fake-zip")), backup.RestoreOptions{DryRun: true}); err == nil {
+		t.Fatal("expected error for a sidecar without dry run support")
+	}
+	if restoreCalled {
+		t.Error("backup was uploaded to a sidecar that would have restored it")
+	}
+}
+
+func TestRestore_DryRunNotConfirmed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/health" {
+			json.NewEncoder(w).Encode(map[string]any{"status": "ok", "dryRun": true})
+			return
+		}
+		io.Copy(io.Discard, r.Body)
+		json.NewEncoder(w).Encode(map[string]any{"success": true, "message": "Restored 2 files"})
+	}))
+	defer srv.Close()
+
+	c, _ := NewClient(srv.URL, "", "testapp")
+	if _, err := c.Restore(context.Background(), bytes.NewReader([]byte("fake-zip")), backup.RestoreOptions{DryRun: true}); err == nil {
+		t.Fatal("expected error for a response without dryRun: true")
+	}
+}
+
 func TestRestore_ServerError(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -193,7 +274,7 @@ func TestRestore_ServerError(t *testing.T) {
 	defer srv.Close()
 
 	c, _ := NewClient(srv.URL, "", "testapp")
-	err := c.Restore(context.Background(), bytes.NewReader([]byte("fake-zip")))
+	_, err := c.Restore(context.Background(), bytes.NewReader([]byte("fake-zip")), backup.RestoreOptions{})
 	if err == nil {
 		t.Fatal("expected error for 500 response")
 	}
@@ -0,0 +1,285 @@
+// Package azure implements a storage backend on Azure Blob Storage. Backups
+// use the same <prefix>/<appName>/<fileName> layout as the S3 backend.
+package azure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+
+	"backuparr/internal/storage"
+)
+
+// Ensure AzureBackend implements storage.Backend at compile time.
+var _ storage.Backend = (*AzureBackend)(nil)
+
+// Config holds the configuration for an Azure Blob Storage backend.
+type Config struct {
+	Container  string
+	Prefix     string // blob name prefix, defaults to "backuparr"
+	AccessTier string // "Hot", "Cool", "Cold" or "Archive"; account default when empty
+
+	// Credentials, in order of precedence: a connection string (also the
+	// way to reach Azurite), the account key, or a SAS token. The latter
+	// two address the storage account by name, or by Endpoint.
+	ConnectionString string
+	Account          string
+	AccountKey       string
+	SASToken         string
+	Endpoint         string // defaults to https://<account>.blob.core.windows.net/
+}
+
+// AzureBackend stores backups as block blobs in an Azure Storage container.
+type AzureBackend struct {
+	client    *azblob.Client
+	container string
+	prefix    string
+	tier      *blob.AccessTier
+	name      string
+}
+
+// New creates a new Azure Blob Storage backend from the given config. It
+// does not connect; connection problems surface on the first operation.
+func New(cfg Config) (*AzureBackend, error) {
+	if cfg.Container == "" {
+		return nil, fmt.Errorf("azure: container is required")
+	}
+
+	prefix := strings.Trim(cfg.Prefix, "/")
+	if prefix == "" {
+		prefix = "backuparr"
+	}
+
+	tier, err := parseAccessTier(cfg.AccessTier)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AzureBackend{
+		client:    client,
+		container: cfg.Container,
+		prefix:    prefix,
+		tier:      tier,
+	}, nil
+}
+
+func newClient(cfg Config) (*azblob.Client, error) {
+	if cfg.ConnectionString != "" {
+		client, err := azblob.NewClientFromConnectionString(cfg.ConnectionString, nil)
+		if err != nil {
+			return nil, fmt.Errorf("azure: invalid connection string: %w", err)
+		}
+		return client, nil
+	}
+
+	serviceURL := cfg.Endpoint
+	if serviceURL == "" {
+		if cfg.Account == "" {
+			return nil, fmt.Errorf("azure: account or connectionString is required")
+		}
+		serviceURL = fmt.Sprintf("https://%s.blob.core.windows.net/", cfg.Account)
+	}
+
+	switch {
+	case cfg.AccountKey != "":
+		if cfg.Account == "" {
+			return nil, fmt.Errorf("azure: account is required with accountKey")
+		}
+		cred, err := azblob.NewSharedKeyCredential(cfg.Account, cfg.AccountKey)
+		if err != nil {
+			return nil, fmt.Errorf("azure: invalid account key: %w", err)
+		}
+		client, err := azblob.NewClientWithSharedKeyCredential(serviceURL, cred, nil)
+		if err != nil {
+			return nil, fmt.Errorf("azure: failed to create client: %w", err)
+		}
+		return client, nil
+	case cfg.SASToken != "":
+		u, err := url.Parse(serviceURL)
+		if err != nil {
+			return nil, fmt.Errorf("azure: invalid endpoint %q: %w", serviceURL, err)
+		}
+		u.RawQuery = strings.TrimPrefix(cfg.SASToken, "?")
+		client, err := azblob.NewClientWithNoCredential(u.String(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("azure: failed to create client: %w", err)
+		}
+		return client, nil
+	default:
+		return nil, fmt.Errorf("azure: connectionString, accountKey or sasToken is required")
+	}
+}
+
+// parseAccessTier accepts the blob access tiers in any case.
+func parseAccessTier(s string) (*blob.AccessTier, error) {
+	if s == "" {
+		return nil, nil
+	}
+	for _, tier := range []blob.AccessTier{blob.AccessTierHot, blob.AccessTierCool, blob.AccessTierCold, blob.AccessTierArchive} {
+		if strings.EqualFold(s, string(tier)) {
+			return &tier, nil
+		}
+	}
+	return nil, fmt.Errorf("azure: unknown access tier %q (want Hot, Cool, Cold or Archive)", s)
+}
+
+func (b *AzureBackend) Type() string { return "azure" }
+
+func (b *AzureBackend) Name() string {
+	if b.name != "" {
+		return b.name
+	}
+	return b.Type()
+}
+
+func (b *AzureBackend) SetName(name string) { b.name = name }
+
+// blobName returns the full blob name for a backup file.
+// Layout: <prefix>/<appName>/<fileName>
+func (b *AzureBackend) blobName(appName, fileName string) string {
+	return path.Join(b.prefix, appName, fileName)
+}
+
+// blockSize is the size of each staged block. UploadStream holds one block
+// per concurrent upload in memory, and with the 50,000 block limit this
+// allows backups up to ~390 GiB.
+const blockSize = 8 << 20
+
+// Upload streams backup data to a block blob. The blob only becomes visible
+// once the block list is committed, so an interrupted upload never shows up
+// as a backup.
+func (b *AzureBackend) Upload(ctx context.Context, appName string, fileName string, data io.Reader, size int64) (*storage.BackupMetadata, error) {
+	name := b.blobName(appName, fileName)
+
+	opts := &azblob.UploadStreamOptions{BlockSize: blockSize, Concurrency: 2}
+	// Manifests stay in the account's default tier: archived blobs cannot
+	// be read without rehydrating them first, and List needs the manifest.
+	if !storage.IsManifestName(fileName) {
+		opts.AccessTier = b.tier
+	}
+
+	body := &storage.CountingReader{R: data}
+	if _, err := b.client.UploadStream(ctx, b.container, name, body, opts); err != nil {
+		return nil, fmt.Errorf("azure: failed to upload %s: %w", name, err)
+	}
+
+	return &storage.BackupMetadata{
+		Key:       name,
+		AppName:   appName,
+		FileName:  fileName,
+		Size:      body.N,
+		Encrypted: storage.IsEncryptedName(fileName),
+	}, nil
+}
+
+// Download retrieves a backup blob. Caller must close the reader.
+func (b *AzureBackend) Download(ctx context.Context, key string) (io.ReadCloser, *storage.BackupMetadata, error) {
+	resp, err := b.client.DownloadStream(ctx, b.container, key, nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.BlobArchived) {
+			return nil, nil, fmt.Errorf("azure: %s is in the Archive tier; rehydrate it to Hot or Cool before restoring", key)
+		}
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return nil, nil, fmt.Errorf("azure: backup not found: %s: %w", key, storage.ErrNotFound)
+		}
+		return nil, nil, fmt.Errorf("azure: failed to download %s: %w", key, err)
+	}
+
+	meta := &storage.BackupMetadata{
+		Key:       key,
+		AppName:   path.Base(path.Dir(key)),
+		FileName:  path.Base(key),
+		Encrypted: storage.IsEncryptedName(key),
+	}
+	if resp.ContentLength != nil {
+		meta.Size = *resp.ContentLength
+	}
+	if resp.LastModified != nil {
+		meta.CreatedAt = *resp.LastModified
+	}
+
+	return resp.Body, meta, nil
+}
+
+// List returns all backups for the given app, sorted newest-first.
+func (b *AzureBackend) List(ctx context.Context, appName string) ([]storage.BackupMetadata, error) {
+	prefix := path.Join(b.prefix, appName) + "/"
+
+	var backups []storage.BackupMetadata
+	manifests := make(map[string]bool)
+	pager := b.client.NewListBlobsFlatPager(b.container, &azblob.ListBlobsFlatOptions{
+		Prefix: &prefix,
+	})
+
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("azure: failed to list blobs with prefix %s: %w", prefix, err)
+		}
+		for _, item := range page.Segment.BlobItems {
+			if item.Name == nil {
+				continue
+			}
+			fileName := strings.TrimPrefix(*item.Name, prefix)
+			if strings.Contains(fileName, "/") {
+				continue
+			}
+			if storage.IsManifestName(fileName) {
+				manifests[fileName] = true
+				continue
+			}
+			if !storage.IsBackupFile(fileName) {
+				continue
+			}
+			meta := storage.BackupMetadata{
+				Key:       *item.Name,
+				AppName:   appName,
+				FileName:  fileName,
+				Encrypted: storage.IsEncryptedName(fileName),
+			}
+			if props := item.Properties; props != nil {
+				if props.ContentLength != nil {
+					meta.Size = *props.ContentLength
+				}
+				if props.LastModified != nil {
+					meta.CreatedAt = *props.LastModified
+				}
+			}
+			backups = append(backups, meta)
+		}
+	}
+
+	// Manifests override LastModified, which changes when blobs are copied.
+	storage.ApplyManifests(backups, manifests, func(meta *storage.BackupMetadata) (io.ReadCloser, error) {
+		rc, _, err := b.Download(ctx, storage.ManifestKey(meta.Key))
+		return rc, err
+	})
+
+	// Sort newest-first
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.After(backups[j].CreatedAt)
+	})
+
+	return backups, nil
+}
+
+// Delete removes a backup blob.
+func (b *AzureBackend) Delete(ctx context.Context, key string) error {
+	if _, err := b.client.DeleteBlob(ctx, b.container, key, nil); err != nil {
+		return fmt.Errorf("azure: failed to delete %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package azure
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+
+	"backuparr/internal/storage"
+)
+
+const (
+	testContainer = "backuparr-test"
+	// Azurite's well-known development account.
+	testConnectionString = "DefaultEndpointsProtocol=http;AccountName=devstoreaccount1;" +
+		"AccountKey=Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw==;" +
+		"BlobEndpoint=http://127.0.0.1:10000/devstoreaccount1;"
+)
+
+func skipUnlessAzurite(t *testing.T) {
+	t.Helper()
+	if os.Getenv("AZURITE_TEST") == "" {
+		t.Skip("AZURITE_TEST not set, skipping Azure integration tests")
+	}
+}
+
+func newTestBackend(t *testing.T, ctx context.Context) *AzureBackend {
+	t.Helper()
+	client, err := azblob.NewClientFromConnectionString(testConnectionString, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateContainer(ctx, testContainer, nil); err != nil && !bloberror.HasCode(err, bloberror.ContainerAlreadyExists) {
+		t.Fatalf("failed to create test container: %v", err)
+	}
+
+	backend, err := New(Config{
+		Container:        testContainer,
+		Prefix:           fmt.Sprintf("test-%d", time.Now().UnixNano()),
+		ConnectionString: testConnectionString,
+		AccessTier:       "cool",
+	})
+	if err != nil {
+		t.Fatalf("failed to create Azure backend: %v", err)
+	}
+	return backend
+}
+
+func TestAzureBackend_RoundTrip(t *testing.T) {
+	skipUnlessAzurite(t)
+	ctx := context.Background()
+	backend := newTestBackend(t, ctx)
+
+	older := storage.FormatBackupName("sonarr", time.Date(2026, 2, 5, 12, 0, 0, 0, time.UTC))
+	newer := storage.FormatBackupName("sonarr", time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC))
+	meta, err := backend.Upload(ctx, "sonarr", older, strings.NewReader("old backup"), 10)
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+	if meta.Size != 10 || meta.Key != backend.prefix+"/sonarr/"+older {
+		t.Errorf("Upload meta = %+v", meta)
+	}
+	m := storage.Manifest{CreatedAt: time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC)}
+	if err := storage.WriteManifest(ctx, backend, meta, m); err != nil {
+		t.Fatal(err)
+	}
+	// Larger than one block, so it is staged in several blocks.
+	data := bytes.Repeat([]byte("x"), blockSize+100)
+	if _, err := backend.Upload(ctx, "sonarr", newer, bytes.NewReader(data), 0); err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+
+	backups, err := backend.List(ctx, "sonarr")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("expected 2 backups, got %+v", backups)
+	}
+	if backups[1].FileName != older || !backups[1].CreatedAt.Equal(m.CreatedAt) {
+		t.Errorf("manifest not applied: %+v", backups[1])
+	}
+	if backups[0].Size != int64(len(data)) {
+		t.Errorf("List size = %d, want %d", backups[0].Size, len(data))
+	}
+
+	props, err := backend.client.ServiceClient().NewContainerClient(testContainer).NewBlobClient(backups[0].Key).GetProperties(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if props.AccessTier == nil || *props.AccessTier != string(blob.AccessTierCool) {
+		t.Errorf("access tier = %v, want Cool", props.AccessTier)
+	}
+
+	reader, dlMeta, err := backend.Download(ctx, backups[0].Key)
+	if err != nil {
+		t.Fatalf("Download failed: %v", err)
+	}
+	downloaded, _ := io.ReadAll(reader)
+	reader.Close()
+	if !bytes.Equal(downloaded, data) || dlMeta.AppName != "sonarr" || dlMeta.FileName != newer {
+		t.Errorf("Download meta = %+v, %d bytes", dlMeta, len(downloaded))
+	}
+
+	if err := storage.DeleteBackup(ctx, backend, meta.Key); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	backups, _ = backend.List(ctx, "sonarr")
+	if len(backups) != 1 {
+		t.Errorf("expected 1 backup after delete, got %d", len(backups))
+	}
+	if _, _, err := backend.Download(ctx, storage.ManifestKey(meta.Key)); err == nil {
+		t.Error("manifest not deleted")
+	}
+
+	if backups, err := backend.List(ctx, "nonexistent"); err != nil || len(backups) != 0 {
+		t.Errorf("List of empty prefix = %v, %v", backups, err)
+	}
+}
+
+func TestAzureBackend_ConfigValidation(t *testing.T) {
+	valid := Config{Container: "backups", Account: "myaccount", AccountKey: "a2V5"}
+	b, err := New(valid)
+	if err != nil {
+		t.Fatalf("valid config: %v", err)
+	}
+	if b.Type() != "azure" || b.Name() != "azure" || b.prefix != "backuparr" {
+		t.Errorf("backend = %+v", b)
+	}
+	if _, err := New(Config{Container: "backups", Account: "myaccount", SASToken: "?sv=2022-11-02&sig=abc"}); err != nil {
+		t.Errorf("SAS token: %v", err)
+	}
+
+	for name, mutate := range map[string]func(*Config){
+		"no container":   func(c *Config) { c.Container = "" },
+		"no account":     func(c *Config) { c.Account = "" },
+		"no credentials": func(c *Config) { c.AccountKey = "" },
+		"bad tier":       func(c *Config) { c.AccessTier = "Frozen" },
+		"bad conn":       func(c *Config) { c.ConnectionString = "not a connection string" },
+	} {
+		cfg := valid
+		mutate(&cfg)
+		if _, err := New(cfg); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestParseAccessTier(t *testing.T) {
+	for in, want := range map[string]blob.AccessTier{"hot": blob.AccessTierHot, "Cool": blob.AccessTierCool, "ARCHIVE": blob.AccessTierArchive} {
+		tier, err := parseAccessTier(in)
+		if err != nil || tier == nil || *tier != want {
+			t.Errorf("parseAccessTier(%q) = %v, %v", in, tier, err)
+		}
+	}
+	if tier, err := parseAccessTier(""); tier != nil || err != nil {
+		t.Errorf("empty tier = %v, %v", tier, err)
+	}
+}
@@ -0,0 +1,166 @@
+// Package encrypted wraps a storage.Backend with client-side age encryption.
+// Backups are encrypted as they stream to the inner backend and stored with
+// an ".age" suffix; downloads of such objects are decrypted transparently.
+// Plain (unencrypted) backups remain readable, so encryption can be enabled
+// on an existing backend without breaking restores of older backups.
+package encrypted
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"filippo.io/age"
+
+	"backuparr/internal/storage"
+)
+
+// Ensure Backend implements storage.Backend at compile time.
+var _ storage.Backend = (*Backend)(nil)
+
+// Config holds the keys used to encrypt and decrypt backups.
+type Config struct {
+	// Passphrase encrypts and decrypts with an scrypt-derived key.
+	Passphrase string
+	// Recipients are age public keys ("age1...") backups are encrypted to.
+	Recipients []string
+	// IdentityFile is an age identity file whose private keys are used to
+	// decrypt backups encrypted to Recipients.
+	IdentityFile string
+}
+
+// Backend encrypts uploads to and decrypts downloads from an inner backend.
+type Backend struct {
+	inner      storage.Backend
+	recipients []age.Recipient
+	identities []age.Identity
+}
+
+// New wraps inner with age encryption. Passphrase and Recipients are
+// mutually exclusive because age cannot mix scrypt with other recipients.
+func New(inner storage.Backend, cfg Config) (*Backend, error) {
+	if cfg.Passphrase != "" && len(cfg.Recipients) > 0 {
+		return nil, errors.New("encryption: passphrase and recipients are mutually exclusive")
+	}
+	if cfg.Passphrase == "" && len(cfg.Recipients) == 0 {
+		return nil, errors.New("encryption: either passphrase or recipients is required")
+	}
+
+	b := &Backend{inner: inner}
+
+	if cfg.Passphrase != "" {
+		r, err := age.NewScryptRecipient(cfg.Passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("encryption: invalid passphrase: %w", err)
+		}
+		id, err := age.NewScryptIdentity(cfg.Passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("encryption: invalid passphrase: %w", err)
+		}
+		b.recipients = append(b.recipients, r)
+		b.identities = append(b.identities, id)
+	}
+
+	for _, s := range cfg.Recipients {
+		r, err := age.ParseX25519Recipient(strings.TrimSpace(s))
+		if err != nil {
+			return nil, fmt.Errorf("encryption: invalid recipient %q: %w", s, err)
+		}
+		b.recipients = append(b.recipients, r)
+	}
+
+	if cfg.IdentityFile != "" {
+		f, err := os.Open(cfg.IdentityFile)
+		if err != nil {
+			return nil, fmt.Errorf("encryption: failed to open identity file: %w", err)
+		}
+		defer f.Close()
+		ids, err := age.ParseIdentities(f)
+		if err != nil {
+			return nil, fmt.Errorf("encryption: failed to parse identity file %s: %w", cfg.IdentityFile, err)
+		}
+		b.identities = append(b.identities, ids...)
+	}
+
+	return b, nil
+}
+
+func (b *Backend) Type() string        { return b.inner.Type() }
+func (b *Backend) Name() string        { return b.inner.Name() }
+func (b *Backend) SetName(name string) { b.inner.SetName(name) }
+
+// Upload encrypts data while streaming it to the inner backend as
+// fileName + ".age". Manifests are stored as-is so backups can be listed
+// and checked without the decryption key.
+func (b *Backend) Upload(ctx context.Context, appName string, fileName string, data io.Reader, size int64) (*storage.BackupMetadata, error) {
+	if storage.IsManifestName(fileName) {
+		return b.inner.Upload(ctx, appName, fileName, data, size)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		w, err := age.Encrypt(pw, b.recipients...)
+		if err != nil {
+			pw.CloseWithError(fmt.Errorf("encryption: %w", err))
+			return
+		}
+		if _, err := io.Copy(w, data); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		pw.CloseWithError(w.Close())
+	}()
+
+	// The ciphertext size differs from size, so let the backend count it.
+	meta, err := b.inner.Upload(ctx, appName, fileName+storage.EncryptedSuffix, pr, 0)
+	// Unblock the encrypting goroutine if the backend stopped reading early.
+	pr.CloseWithError(errUploadAborted)
+	if err != nil {
+		return nil, err
+	}
+	meta.Encrypted = true
+	return meta, nil
+}
+
+var errUploadAborted = errors.New("encryption: upload aborted")
+
+// Download fetches a backup and decrypts it if it was stored encrypted.
+// The returned metadata describes the stored (encrypted) object.
+func (b *Backend) Download(ctx context.Context, key string) (io.ReadCloser, *storage.BackupMetadata, error) {
+	rc, meta, err := b.inner.Download(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !storage.IsEncryptedName(key) {
+		return rc, meta, nil
+	}
+	meta.Encrypted = true
+
+	if len(b.identities) == 0 {
+		rc.Close()
+		return nil, nil, fmt.Errorf("encryption: %s is encrypted but no passphrase or identityFile is configured", key)
+	}
+	r, err := age.Decrypt(rc, b.identities...)
+	if err != nil {
+		rc.Close()
+		return nil, nil, fmt.Errorf("encryption: failed to decrypt %s: %w", key, err)
+	}
+	return &decryptReader{Reader: r, Closer: rc}, meta, nil
+}
+
+// decryptReader reads plaintext from r and closes the underlying download.
+type decryptReader struct {
+	io.Reader
+	io.Closer
+}
+
+func (b *Backend) List(ctx context.Context, appName string) ([]storage.BackupMetadata, error) {
+	return b.inner.List(ctx, appName)
+}
+
+func (b *Backend) Delete(ctx context.Context, key string) error {
+	return b.inner.Delete(ctx, key)
+}
@@ -0,0 +1,169 @@
+package encrypted
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+
+	"backuparr/internal/storage"
+	"backuparr/internal/storage/local"
+)
+
+func roundTrip(t *testing.T, b *Backend, dir string, data []byte) {
+	t.Helper()
+	ctx := context.Background()
+
+	meta, err := b.Upload(ctx, "sonarr", "sonarr_2026-02-06T030000Z.zip", bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if !meta.Encrypted || !strings.HasSuffix(meta.FileName, ".zip.age") {
+		t.Errorf("meta = %+v, want encrypted .zip.age", meta)
+	}
+
+	stored, err := os.ReadFile(filepath.Join(dir, "sonarr", meta.FileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(stored, data[:32]) {
+		t.Error("stored object contains plaintext")
+	}
+
+	backups, err := b.List(ctx, "sonarr")
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(backups) != 1 || !backups[0].Encrypted {
+		t.Fatalf("List = %+v, want one encrypted backup", backups)
+	}
+
+	rc, _, err := b.Download(ctx, backups[0].Key)
+	if err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	defer rc.Close()
+	got, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Error("decrypted data mismatch")
+	}
+}
+
+func TestPassphraseRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	b, err := New(local.New(dir), Config{Passphrase: "correct horse battery staple"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roundTrip(t, b, dir, bytes.Repeat([]byte("config.xml apikey=secret "), 100_000))
+}
+
+func TestRecipientRoundTrip(t *testing.T) {
+	id, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	idFile := filepath.Join(t.TempDir(), "age.key")
+	if err := os.WriteFile(idFile, []byte(id.String()+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := New(local.New(dir), Config{
+		Recipients:   []string{id.Recipient().String()},
+		IdentityFile: idFile,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roundTrip(t, b, dir, bytes.Repeat([]byte("postgres password=hunter2 "), 1000))
+}
+
+func TestDownloadWithoutIdentity(t *testing.T) {
+	id, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	b, err := New(local.New(dir), Config{Recipients: []string{id.Recipient().String()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta, err := b.Upload(context.Background(), "sonarr", "sonarr.zip", strings.NewReader("data"), 4)
+	if err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if _, _, err := b.Download(context.Background(), meta.Key); err == nil {
+		t.Error("expected error downloading encrypted backup without an identity")
+	}
+}
+
+func TestPlainBackupPassthrough(t *testing.T) {
+	dir := t.TempDir()
+	inner := local.New(dir)
+	meta, err := inner.Upload(context.Background(), "sonarr", "sonarr.zip", strings.NewReader("plain"), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := New(inner, Config{Passphrase: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rc, got, err := b.Download(context.Background(), meta.Key)
+	if err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	defer rc.Close()
+	data, _ := io.ReadAll(rc)
+	if string(data) != "plain" || got.Encrypted {
+		t.Errorf("got %q (encrypted=%v), want plain passthrough", data, got.Encrypted)
+	}
+}
+
+func TestNew_Validation(t *testing.T) {
+	inner := local.New(t.TempDir())
+	if _, err := New(inner, Config{}); err == nil {
+		t.Error("expected error with no passphrase or recipients")
+	}
+	if _, err := New(inner, Config{Passphrase: "x", Recipients: []string{"age1x"}}); err == nil {
+		t.Error("expected error with both passphrase and recipients")
+	}
+	if _, err := New(inner, Config{Recipients: []string{"not-a-key"}}); err == nil {
+		t.Error("expected error for invalid recipient")
+	}
+}
+
+func TestManifestStoredInPlaintext(t *testing.T) {
+	dir := t.TempDir()
+	b, err := New(local.New(dir), Config{Passphrase: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	meta, err := b.Upload(ctx, "sonarr", "sonarr.zip", strings.NewReader("data"), 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.WriteManifest(ctx, b, meta, storage.Manifest{SHA256: "abc"}); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := storage.ReadManifest(ctx, local.New(dir), meta.Key)
+	if err != nil {
+		t.Fatalf("manifest not readable without key: %v", err)
+	}
+	if !m.Encrypted || m.FileName != "sonarr.zip.age" {
+		t.Errorf("manifest = %+v, want encrypted sonarr.zip.age", m)
+	}
+}
@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+)
+
+// fanOutChunkSize is the size of each read from the source stream. Memory
+// use during a fan-out upload is bounded by roughly one chunk per backend,
+// independent of the total backup size.
+const fanOutChunkSize = 1 << 20
+
+// UploadResult is the outcome of uploading a backup to a single backend.
+type UploadResult struct {
+	Backend Backend
+	Meta    *BackupMetadata
+	Err     error
+}
+
+// UploadAll streams data to every backend concurrently. The source is read
+// exactly once; each chunk is handed to every backend that is still
+// accepting data through an io.Pipe, so a slow backend applies back-pressure
+// and a failed backend is dropped without aborting the others.
+//
+// Results are returned in the same order as backends. An error reading the
+// source is propagated to every backend that had not already failed.
+func UploadAll(ctx context.Context, backends []Backend, appName, fileName string, data io.Reader, size int64) []UploadResult {
+	results := make([]UploadResult, len(backends))
+	writers := make([]*io.PipeWriter, len(backends))
+
+	var wg sync.WaitGroup
+	for i, b := range backends {
+		pr, pw := io.Pipe()
+		writers[i] = pw
+		results[i].Backend = b
+
+		wg.Add(1)
+		go func(i int, b Backend, pr *io.PipeReader) {
+			defer wg.Done()
+			meta, err := b.Upload(ctx, appName, fileName, pr, size)
+			if err == nil {
+				// Make sure the backend consumed everything. A backend that
+				// returns early without error would otherwise stall the
+				// writer loop below.
+				if n, _ := io.Copy(io.Discard, pr); n > 0 {
+					err = errors.New("backend did not consume the full backup stream")
+				}
+			}
+			// Unblock the writer if the backend stopped reading early.
+			pr.CloseWithError(errBackendClosed)
+			results[i].Meta = meta
+			results[i].Err = err
+		}(i, b, pr)
+	}
+
+	srcErr := fanOut(data, writers)
+
+	for _, pw := range writers {
+		if srcErr != nil {
+			pw.CloseWithError(srcErr)
+		} else {
+			pw.Close()
+		}
+	}
+	wg.Wait()
+
+	return results
+}
+
+// errBackendClosed is returned to the fan-out writer when a backend stops
+// reading from its pipe.
+var errBackendClosed = errors.New("backend closed upload stream")
+
+// fanOut copies src to each writer until EOF. Writers that return an error
+// are dropped. Returns the first error from reading src, if any.
+func fanOut(src io.Reader, writers []*io.PipeWriter) error {
+	active := make([]bool, len(writers))
+	remaining := len(writers)
+	for i := range active {
+		active[i] = true
+	}
+
+	buf := make([]byte, fanOutChunkSize)
+	for remaining > 0 {
+		n, err := src.Read(buf)
+		if n > 0 {
+			for i, w := range writers {
+				if !active[i] {
+					continue
+				}
+				if _, werr := w.Write(buf[:n]); werr != nil {
+					active[i] = false
+					remaining--
+				}
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// Every backend failed; stop reading the source.
+	return nil
+}
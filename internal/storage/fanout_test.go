@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+// memBackend is an in-memory Backend for fan-out tests.
+type memBackend struct {
+	name     string
+	failAt   int // fail after reading this many bytes; <0 never fails
+	received bytes.Buffer
+}
+
+func (m *memBackend) Type() string        { return "mem" }
+func (m *memBackend) Name() string        { return m.name }
+func (m *memBackend) SetName(name string) { m.name = name }
+
+func (m *memBackend) Upload(ctx context.Context, appName, fileName string, data io.Reader, size int64) (*BackupMetadata, error) {
+	if m.failAt >= 0 {
+		if _, err := io.CopyN(&m.received, data, int64(m.failAt)); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("disk full")
+	}
+	n, err := io.Copy(&m.received, data)
+	if err != nil {
+		return nil, err
+	}
+	return &BackupMetadata{Key: appName + "/" + fileName, AppName: appName, FileName: fileName, Size: n}, nil
+}
+
+func (m *memBackend) Download(ctx context.Context, key string) (io.ReadCloser, *BackupMetadata, error) {
+	return nil, nil, errors.New("not implemented")
+}
+
+func (m *memBackend) List(ctx context.Context, appName string) ([]BackupMetadata, error) {
+	return nil, nil
+}
+
+func (m *memBackend) Delete(ctx context.Context, key string) error { return nil }
+
+func TestUploadAll_AllSucceed(t *testing.T) {
+	data := bytes.Repeat([]byte("backuparr"), 500_000) // > several chunks
+	a := &memBackend{name: "a", failAt: -1}
+	b := &memBackend{name: "b", failAt: -1}
+
+	results := UploadAll(context.Background(), []Backend{a, b}, "sonarr", "sonarr.zip", bytes.NewReader(data), int64(len(data)))
+
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	for i, r := range results {
+		if r.Err != nil {
+			t.Fatalf("result %d: unexpected error: %v", i, r.Err)
+		}
+		if r.Meta.Size != int64(len(data)) {
+			t.Errorf("result %d: size = %d, want %d", i, r.Meta.Size, len(data))
+		}
+	}
+	if !bytes.Equal(a.received.Bytes(), data) || !bytes.Equal(b.received.Bytes(), data) {
+		t.Error("backend data mismatch")
+	}
+}
+
+func TestUploadAll_OneBackendFails(t *testing.T) {
+	data := bytes.Repeat([]byte("x"), 3*fanOutChunkSize)
+	bad := &memBackend{name: "bad", failAt: 100}
+	good := &memBackend{name: "good", failAt: -1}
+
+	results := UploadAll(context.Background(), []Backend{bad, good}, "sonarr", "sonarr.zip", bytes.NewReader(data), int64(len(data)))
+
+	if results[0].Err == nil {
+		t.Error("expected error from failing backend")
+	}
+	if results[1].Err != nil {
+		t.Fatalf("healthy backend failed: %v", results[1].Err)
+	}
+	if !bytes.Equal(good.received.Bytes(), data) {
+		t.Errorf("healthy backend received %d bytes, want %d", good.received.Len(), len(data))
+	}
+}
+
+type failingReader struct {
+	data []byte
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, r.err
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestUploadAll_SourceError(t *testing.T) {
+	srcErr := errors.New("sidecar connection reset")
+	src := &failingReader{data: []byte("partial zip"), err: srcErr}
+	a := &memBackend{name: "a", failAt: -1}
+	b := &memBackend{name: "b", failAt: -1}
+
+	results := UploadAll(context.Background(), []Backend{a, b}, "sonarr", "sonarr.zip", src, 0)
+
+	for i, r := range results {
+		if !errors.Is(r.Err, srcErr) {
+			t.Errorf("result %d: err = %v, want %v", i, r.Err, srcErr)
+		}
+	}
+}
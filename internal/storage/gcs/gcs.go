@@ -0,0 +1,519 @@
+// Package gcs implements a storage backend on Google Cloud Storage. Backups
+// use the same <prefix>/<appName>/<fileName> layout as the S3 backend.
+//
+// The backend talks to the GCS JSON API directly instead of going through
+// cloud.google.com/go/storage, which would pull in gRPC and OpenTelemetry
+// for the handful of calls needed here.
+package gcs
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+
+	"backuparr/internal/storage"
+)
+
+// Ensure GCSBackend implements storage.Backend at compile time.
+var _ storage.Backend = (*GCSBackend)(nil)
+
+// Config holds the configuration for a Google Cloud Storage backend.
+type Config struct {
+	Bucket       string
+	Prefix       string // object name prefix, defaults to "backuparr"
+	StorageClass string // "STANDARD", "NEARLINE", "COLDLINE" or "ARCHIVE"; bucket default when empty
+
+	// A service account key, as a file or inline JSON. Without one,
+	// Application Default Credentials are used (GOOGLE_APPLICATION_CREDENTIALS,
+	// gcloud, or the metadata server on GCE/GKE).
+	CredentialsFile string
+	CredentialsJSON string
+
+	// Endpoint overrides https://storage.googleapis.com, e.g. for
+	// fake-gcs-server. Requests to a custom endpoint without credentials
+	// are sent unauthenticated.
+	Endpoint string
+}
+
+// GCSBackend stores backups as objects in a Google Cloud Storage bucket.
+type GCSBackend struct {
+	client       *http.Client
+	endpoint     string
+	bucket       string
+	prefix       string
+	storageClass string
+	name         string
+}
+
+const scope = "https://www.googleapis.com/auth/devstorage.read_write"
+
+// New creates a new GCS storage backend from the given config.
+func New(ctx context.Context, cfg Config) (*GCSBackend, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("gcs: bucket is required")
+	}
+
+	prefix := strings.Trim(cfg.Prefix, "/")
+	if prefix == "" {
+		prefix = "backuparr"
+	}
+
+	storageClass := strings.ToUpper(cfg.StorageClass)
+	switch storageClass {
+	case "", "STANDARD", "NEARLINE", "COLDLINE", "ARCHIVE":
+	default:
+		return nil, fmt.Errorf("gcs: unknown storage class %q (want STANDARD, NEARLINE, COLDLINE or ARCHIVE)", cfg.StorageClass)
+	}
+
+	endpoint := strings.TrimSuffix(cfg.Endpoint, "/")
+	if endpoint == "" {
+		endpoint = "https://storage.googleapis.com"
+	}
+
+	client, err := newClient(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GCSBackend{
+		client:       client,
+		endpoint:     endpoint,
+		bucket:       cfg.Bucket,
+		prefix:       prefix,
+		storageClass: storageClass,
+	}, nil
+}
+
+// newClient returns an HTTP client that authenticates requests.
+func newClient(ctx context.Context, cfg Config) (*http.Client, error) {
+	data := []byte(cfg.CredentialsJSON)
+	if cfg.CredentialsFile != "" {
+		var err error
+		data, err = os.ReadFile(cfg.CredentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("gcs: failed to read credentials file: %w", err)
+		}
+	}
+
+	var creds *google.Credentials
+	var err error
+	switch {
+	case len(data) > 0:
+		creds, err = google.CredentialsFromJSON(ctx, data, scope)
+		if err != nil {
+			return nil, fmt.Errorf("gcs: invalid credentials: %w", err)
+		}
+	case cfg.Endpoint != "":
+		return &http.Client{}, nil
+	default:
+		creds, err = google.FindDefaultCredentials(ctx, scope)
+		if err != nil {
+			return nil, fmt.Errorf("gcs: no credentials configured and no application default credentials found: %w", err)
+		}
+	}
+	// The token source refreshes with its own context, not the caller's.
+	return oauth2.NewClient(context.Background(), creds.TokenSource), nil
+}
+
+func (b *GCSBackend) Type() string { return "gcs" }
+
+func (b *GCSBackend) Name() string {
+	if b.name != "" {
+		return b.name
+	}
+	return b.Type()
+}
+
+func (b *GCSBackend) SetName(name string) { b.name = name }
+
+// objectName returns the full object name for a backup file.
+// Layout: <prefix>/<appName>/<fileName>
+func (b *GCSBackend) objectName(appName, fileName string) string {
+	return path.Join(b.prefix, appName, fileName)
+}
+
+// objectURL returns the JSON API URL of an object. Object names are escaped
+// as a single path segment, slashes included.
+func (b *GCSBackend) objectURL(name string) string {
+	return fmt.Sprintf("%s/storage/v1/b/%s/o/%s", b.endpoint, url.PathEscape(b.bucket), url.PathEscape(name))
+}
+
+// apiError describes an unsuccessful JSON API response and closes its body.
+func apiError(resp *http.Response) error {
+	defer resp.Body.Close()
+	var body struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&body); err == nil && body.Error.Message != "" {
+		return fmt.Errorf("%s: %s", resp.Status, body.Error.Message)
+	}
+	return fmt.Errorf("%s", resp.Status)
+}
+
+// chunkSize is the size of each resumable upload request. It must be a
+// multiple of 256 KiB. Upload buffers one chunk in memory, so memory use is
+// bounded regardless of backup size.
+var chunkSize = 16 << 20
+
+// Upload streams backup data to an object with a resumable upload. The
+// object only becomes visible once the final chunk is written, so an
+// interrupted upload never shows up as a backup.
+func (b *GCSBackend) Upload(ctx context.Context, appName string, fileName string, data io.Reader, size int64) (*storage.BackupMetadata, error) {
+	name := b.objectName(appName, fileName)
+
+	session, err := b.startUpload(ctx, name, fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(data)
+	buf := make([]byte, chunkSize)
+	var written int64
+	for {
+		n, err := io.ReadFull(br, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			b.cancelUpload(session)
+			return nil, fmt.Errorf("gcs: failed to read backup data: %w", err)
+		}
+		final := n < chunkSize
+		if !final {
+			// A full chunk may still be the last one.
+			if _, err := br.Peek(1); err == io.EOF {
+				final = true
+			}
+		}
+		if err := b.uploadChunk(ctx, session, buf[:n], written, final); err != nil {
+			b.cancelUpload(session)
+			return nil, fmt.Errorf("gcs: failed to upload %s: %w", name, err)
+		}
+		written += int64(n)
+		if final {
+			break
+		}
+	}
+
+	return &storage.BackupMetadata{
+		Key:       name,
+		AppName:   appName,
+		FileName:  fileName,
+		Size:      written,
+		Encrypted: storage.IsEncryptedName(fileName),
+	}, nil
+}
+
+// startUpload starts a resumable upload session and returns its URL.
+func (b *GCSBackend) startUpload(ctx context.Context, name, fileName string) (string, error) {
+	object := map[string]string{"name": name}
+	// Manifests are tiny and read on every List, so they stay in the
+	// bucket's default class.
+	if b.storageClass != "" && !storage.IsManifestName(fileName) {
+		object["storageClass"] = b.storageClass
+	}
+	body, _ := json.Marshal(object)
+
+	u := fmt.Sprintf("%s/upload/storage/v1/b/%s/o?uploadType=resumable", b.endpoint, url.PathEscape(b.bucket))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("gcs: failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("gcs: failed to start upload of %s: %w", name, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("gcs: failed to start upload of %s: %w", name, apiError(resp))
+	}
+	resp.Body.Close()
+
+	session := resp.Header.Get("Location")
+	if session == "" {
+		return "", fmt.Errorf("gcs: upload of %s returned no session URL", name)
+	}
+	return session, nil
+}
+
+// maxChunkRetries is how many times a chunk is retried after a transient
+// failure before the upload is abandoned. The delay between attempts starts
+// at retryDelay and doubles each time.
+const maxChunkRetries = 5
+
+var retryDelay = time.Second
+
+// uploadChunk sends one chunk of a resumable upload starting at offset and
+// returns once the server has persisted all of it. Only the final chunk
+// declares the total size. The server may persist less than was sent, in
+// which case the rest of the chunk is sent again. Network errors, 429 and
+// 5xx responses are retried with backoff against the same session, after
+// asking the server how much of the chunk it already has.
+func (b *GCSBackend) uploadChunk(ctx context.Context, session string, chunk []byte, offset int64, final bool) error {
+	end := offset + int64(len(chunk))
+	total := "*"
+	if final {
+		total = strconv.FormatInt(end, 10)
+	}
+
+	next := offset
+	query := false
+	failures := 0
+	for {
+		body := chunk[next-offset:]
+		if query {
+			body = nil
+		}
+		persisted, complete, err := b.putRange(ctx, session, body, next, total)
+		if err == nil && !complete && !query && persisted == next {
+			err = &retryableError{fmt.Errorf("server persisted none of bytes %d-%d", next, end-1)}
+		}
+		if err != nil {
+			var retryable *retryableError
+			if !errors.As(err, &retryable) || failures == maxChunkRetries || ctx.Err() != nil {
+				return err
+			}
+			delay := retryDelay << failures
+			failures++
+			log.Printf("[gcs] Upload of bytes %d-%d failed: %v (retrying in %v)", next, end-1, err, delay)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+			query = true
+			continue
+		}
+
+		if complete {
+			if !final {
+				return fmt.Errorf("server completed the upload before the final chunk")
+			}
+			return nil
+		}
+		if persisted < next || persisted > end {
+			return fmt.Errorf("server persisted bytes 0-%d, expected up to %d", persisted-1, end-1)
+		}
+		if persisted == end && !final {
+			return nil
+		}
+		// Resume from what the server has. Once a final chunk is fully
+		// persisted, the empty body asks the server to complete the upload.
+		next = persisted
+		query = false
+	}
+}
+
+// retryableError marks a failure worth retrying against the same upload
+// session.
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string { return e.err.Error() }
+func (e *retryableError) Unwrap() error { return e.err }
+
+// putRange sends body to a resumable upload session as the bytes starting
+// at from, or asks for the upload's status if body is empty. It returns how
+// many bytes the server has persisted, or complete once the object exists.
+func (b *GCSBackend) putRange(ctx context.Context, session string, body []byte, from int64, total string) (persisted int64, complete bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, session, bytes.NewReader(body))
+	if err != nil {
+		return 0, false, err
+	}
+	if len(body) == 0 {
+		req.Header.Set("Content-Range", "bytes */"+total)
+	} else {
+		req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%s", from, from+int64(len(body))-1, total))
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return 0, false, &retryableError{err}
+	}
+	switch {
+	case resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated:
+		resp.Body.Close()
+		return 0, true, nil
+	case resp.StatusCode == http.StatusPermanentRedirect:
+		resp.Body.Close()
+		// Without a Range header nothing has been persisted yet.
+		r := resp.Header.Get("Range")
+		if r == "" {
+			return 0, false, nil
+		}
+		last, ok := strings.CutPrefix(r, "bytes=0-")
+		n, err := strconv.ParseInt(last, 10, 64)
+		if !ok || err != nil {
+			return 0, false, fmt.Errorf("unexpected Range header %q", r)
+		}
+		return n + 1, false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return 0, false, &retryableError{apiError(resp)}
+	default:
+		return 0, false, apiError(resp)
+	}
+}
+
+// cancelUpload abandons a resumable upload session. It uses a fresh context
+// so the session is still cancelled if ctx was.
+func (b *GCSBackend) cancelUpload(session string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, session, nil)
+	if err != nil {
+		return
+	}
+	if resp, err := b.client.Do(req); err == nil {
+		resp.Body.Close()
+	}
+}
+
+// Download retrieves a backup object. Caller must close the reader.
+func (b *GCSBackend) Download(ctx context.Context, key string) (io.ReadCloser, *storage.BackupMetadata, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.objectURL(key)+"?alt=media", nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gcs: failed to create request: %w", err)
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gcs: failed to download %s: %w", key, err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, nil, fmt.Errorf("gcs: backup not found: %s: %w", key, storage.ErrNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("gcs: failed to download %s: %w", key, apiError(resp))
+	}
+
+	meta := &storage.BackupMetadata{
+		Key:       key,
+		AppName:   path.Base(path.Dir(key)),
+		FileName:  path.Base(key),
+		Size:      resp.ContentLength,
+		Encrypted: storage.IsEncryptedName(key),
+	}
+	if t, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		meta.CreatedAt = t
+	}
+	return resp.Body, meta, nil
+}
+
+// objectList is the subset of an objects.list response used by List.
+type objectList struct {
+	Items []struct {
+		Name    string    `json:"name"`
+		Size    string    `json:"size"` // int64 encoded as a string
+		Updated time.Time `json:"updated"`
+	} `json:"items"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+// List returns all backups for the given app, sorted newest-first.
+func (b *GCSBackend) List(ctx context.Context, appName string) ([]storage.BackupMetadata, error) {
+	prefix := path.Join(b.prefix, appName) + "/"
+
+	var backups []storage.BackupMetadata
+	manifests := make(map[string]bool)
+	pageToken := ""
+	for {
+		q := url.Values{"prefix": {prefix}, "delimiter": {"/"}}
+		if pageToken != "" {
+			q.Set("pageToken", pageToken)
+		}
+		page, err := b.listPage(ctx, q)
+		if err != nil {
+			return nil, fmt.Errorf("gcs: failed to list objects with prefix %s: %w", prefix, err)
+		}
+		for _, obj := range page.Items {
+			fileName := strings.TrimPrefix(obj.Name, prefix)
+			if storage.IsManifestName(fileName) {
+				manifests[fileName] = true
+				continue
+			}
+			if !storage.IsBackupFile(fileName) {
+				continue
+			}
+			size, _ := strconv.ParseInt(obj.Size, 10, 64)
+			backups = append(backups, storage.BackupMetadata{
+				Key:       obj.Name,
+				AppName:   appName,
+				FileName:  fileName,
+				Size:      size,
+				CreatedAt: obj.Updated,
+				Encrypted: storage.IsEncryptedName(fileName),
+			})
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+
+	// Manifests override the update time, which changes on rewrites.
+	storage.ApplyManifests(backups, manifests, func(meta *storage.BackupMetadata) (io.ReadCloser, error) {
+		rc, _, err := b.Download(ctx, storage.ManifestKey(meta.Key))
+		return rc, err
+	})
+
+	// Sort newest-first
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.After(backups[j].CreatedAt)
+	})
+
+	return backups, nil
+}
+
+func (b *GCSBackend) listPage(ctx context.Context, q url.Values) (*objectList, error) {
+	u := fmt.Sprintf("%s/storage/v1/b/%s/o?%s", b.endpoint, url.PathEscape(b.bucket), q.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, apiError(resp)
+	}
+	defer resp.Body.Close()
+	var page objectList
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &page, nil
+}
+
+// Delete removes a backup object.
+func (b *GCSBackend) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, b.objectURL(key), nil)
+	if err != nil {
+		return fmt.Errorf("gcs: failed to create request: %w", err)
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("gcs: failed to delete %s: %w", key, err)
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("gcs: failed to delete %s: %w", key, apiError(resp))
+	}
+	resp.Body.Close()
+	return nil
+}
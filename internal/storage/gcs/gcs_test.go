@@ -0,0 +1,349 @@
+package gcs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"backuparr/internal/storage"
+)
+
+// fakeGCS implements the subset of the JSON API used by the backend:
+// resumable uploads, objects.list, media downloads and deletes.
+type fakeGCS struct {
+	mu       sync.Mutex
+	objects  map[string][]byte
+	classes  map[string]string
+	sessions map[string]*bytes.Buffer
+	names    map[string]string
+	chunks   int
+	// downloads records the objects fetched by media downloads.
+	downloads []string
+
+	// shortWrites makes that many upload requests persist only the first
+	// half of their data, and unavailable answers that many with a 503.
+	shortWrites int
+	unavailable int
+}
+
+func newFakeGCS(t *testing.T) (*fakeGCS, *httptest.Server) {
+	f := &fakeGCS{
+		objects:  make(map[string][]byte),
+		classes:  make(map[string]string),
+		sessions: make(map[string]*bytes.Buffer),
+		names:    make(map[string]string),
+	}
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/upload/storage/v1/b/backups/o":
+			var obj map[string]string
+			json.NewDecoder(r.Body).Decode(&obj)
+			id := strconv.Itoa(len(f.sessions))
+			f.sessions[id] = &bytes.Buffer{}
+			f.names[id] = obj["name"]
+			f.classes[obj["name"]] = obj["storageClass"]
+			w.Header().Set("Location", srv.URL+"/session/"+id)
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/session/"):
+			id := strings.TrimPrefix(r.URL.Path, "/session/")
+			f.chunks++
+			if f.unavailable > 0 {
+				f.unavailable--
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			// Content-Range is "bytes <first>-<last>/<total>", or
+			// "bytes */<total>" for a request without data.
+			rng, total, _ := strings.Cut(strings.TrimPrefix(r.Header.Get("Content-Range"), "bytes "), "/")
+			first, _, _ := strings.Cut(rng, "-")
+			data, _ := io.ReadAll(r.Body)
+			buf := f.sessions[id]
+			if len(data) > 0 {
+				start, _ := strconv.Atoi(first)
+				if start > buf.Len() {
+					http.Error(w, "gap in upload", http.StatusBadRequest)
+					return
+				}
+				if f.shortWrites > 0 && len(data) > 1 {
+					f.shortWrites--
+					data = data[:len(data)/2]
+				}
+				buf.Truncate(start)
+				buf.Write(data)
+			}
+			if total == "*" || strconv.Itoa(buf.Len()) != total {
+				if buf.Len() > 0 {
+					w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", buf.Len()-1))
+				}
+				w.WriteHeader(http.StatusPermanentRedirect)
+				return
+			}
+			f.objects[f.names[id]] = buf.Bytes()
+		case r.Method == http.MethodGet && r.URL.Path == "/storage/v1/b/backups/o":
+			var list objectList
+			for name, data := range f.objects {
+				if strings.HasPrefix(name, r.URL.Query().Get("prefix")) {
+					list.Items = append(list.Items, struct {
+						Name    string    `json:"name"`
+						Size    string    `json:"size"`
+						Updated time.Time `json:"updated"`
+					}{name, strconv.Itoa(len(data)), time.Now()})
+				}
+			}
+			json.NewEncoder(w).Encode(list)
+		case strings.HasPrefix(r.URL.Path, "/storage/v1/b/backups/o/"):
+			name, _ := url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), "/storage/v1/b/backups/o/"))
+			data, ok := f.objects[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"error":{"message":"No such object"}}`)
+				return
+			}
+			if r.Method == http.MethodDelete {
+				delete(f.objects, name)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			f.downloads = append(f.downloads, name)
+			w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+			w.Write(data)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+// testRoundTrip exercises upload, list, download and delete on a backend
+// whose bucket starts out empty below its prefix.
+func testRoundTrip(t *testing.T, b *GCSBackend) {
+	ctx := context.Background()
+
+	older := storage.FormatBackupName("sonarr", time.Date(2026, 2, 5, 12, 0, 0, 0, time.UTC))
+	newer := storage.FormatBackupName("sonarr", time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC))
+	meta, err := b.Upload(ctx, "sonarr", older, strings.NewReader("old backup"), 10)
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+	if meta.Size != 10 || meta.Key != b.prefix+"/sonarr/"+older {
+		t.Errorf("Upload meta = %+v", meta)
+	}
+	m := storage.Manifest{CreatedAt: time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC)}
+	if err := storage.WriteManifest(ctx, b, meta, m); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Upload(ctx, "sonarr", newer, strings.NewReader("newest backup"), 0); err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+
+	backups, err := b.List(ctx, "sonarr")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("expected 2 backups, got %+v", backups)
+	}
+	if backups[0].FileName != newer || backups[0].Size != 13 {
+		t.Errorf("List[0] = %+v", backups[0])
+	}
+	if !backups[1].CreatedAt.Equal(m.CreatedAt) {
+		t.Errorf("manifest not applied: %+v", backups[1])
+	}
+
+	reader, dlMeta, err := b.Download(ctx, backups[0].Key)
+	if err != nil {
+		t.Fatalf("Download failed: %v", err)
+	}
+	data, _ := io.ReadAll(reader)
+	reader.Close()
+	if string(data) != "newest backup" || dlMeta.AppName != "sonarr" || dlMeta.FileName != newer {
+		t.Errorf("Download = %q, %+v", data, dlMeta)
+	}
+
+	if err := storage.DeleteBackup(ctx, b, meta.Key); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	backups, _ = b.List(ctx, "sonarr")
+	if len(backups) != 1 {
+		t.Errorf("expected 1 backup after delete, got %d", len(backups))
+	}
+	if _, _, err := b.Download(ctx, storage.ManifestKey(meta.Key)); err == nil {
+		t.Error("manifest not deleted")
+	}
+
+	if backups, err := b.List(ctx, "nonexistent"); err != nil || len(backups) != 0 {
+		t.Errorf("List of empty prefix = %v, %v", backups, err)
+	}
+}
+
+func TestGCSBackend_RoundTrip(t *testing.T) {
+	f, srv := newFakeGCS(t)
+	defer func(n int) { chunkSize = n }(chunkSize)
+	chunkSize = 4
+
+	b, err := New(context.Background(), Config{Bucket: "backups", Endpoint: srv.URL, StorageClass: "coldline"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testRoundTrip(t, b)
+
+	// "newest backup" alone takes 4 chunks.
+	if f.chunks < 4 {
+		t.Errorf("chunks = %d", f.chunks)
+	}
+	for name, class := range f.classes {
+		want := "COLDLINE"
+		if storage.IsManifestName(name) {
+			want = ""
+		}
+		if class != want {
+			t.Errorf("storage class of %s = %q, want %q", name, class, want)
+		}
+	}
+}
+
+func TestGCSBackend_ListReadsListedManifestsOnly(t *testing.T) {
+	f, srv := newFakeGCS(t)
+	b, err := New(context.Background(), Config{Bucket: "backups", Endpoint: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	var metas []*storage.BackupMetadata
+	for day := 5; day <= 7; day++ {
+		name := storage.FormatBackupName("sonarr", time.Date(2026, 2, day, 12, 0, 0, 0, time.UTC))
+		meta, err := b.Upload(ctx, "sonarr", name, strings.NewReader("backup"), 6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		metas = append(metas, meta)
+	}
+	if err := storage.WriteManifest(ctx, b, metas[1], storage.Manifest{CreatedAt: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+
+	backups, err := b.List(ctx, "sonarr")
+	if err != nil || len(backups) != 3 {
+		t.Fatalf("List = %+v, %v", backups, err)
+	}
+	if want := storage.ManifestKey(metas[1].Key); len(f.downloads) != 1 || f.downloads[0] != want {
+		t.Errorf("List fetched %v, want only %s", f.downloads, want)
+	}
+}
+
+func TestGCSBackend_ExactChunk(t *testing.T) {
+	f, srv := newFakeGCS(t)
+	defer func(n int) { chunkSize = n }(chunkSize)
+	chunkSize = 4
+
+	b, _ := New(context.Background(), Config{Bucket: "backups", Endpoint: srv.URL})
+	meta, err := b.Upload(context.Background(), "sonarr", "sonarr_2026-02-06T120000Z.zip", strings.NewReader("12345678"), 8)
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+	if meta.Size != 8 || string(f.objects[meta.Key]) != "12345678" || f.chunks != 2 {
+		t.Errorf("meta = %+v, stored %q in %d chunks", meta, f.objects[meta.Key], f.chunks)
+	}
+}
+
+func TestGCSBackend_ShortWrite(t *testing.T) {
+	f, srv := newFakeGCS(t)
+	defer func(n int) { chunkSize = n }(chunkSize)
+	chunkSize = 4
+	// Every request carrying more than one byte is cut short, including
+	// the final one.
+	f.shortWrites = 5
+
+	b, _ := New(context.Background(), Config{Bucket: "backups", Endpoint: srv.URL})
+	meta, err := b.Upload(context.Background(), "sonarr", "sonarr_2026-02-06T120000Z.zip", strings.NewReader("0123456789"), 10)
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+	if string(f.objects[meta.Key]) != "0123456789" || f.shortWrites != 0 {
+		t.Errorf("stored %q, %d short writes left", f.objects[meta.Key], f.shortWrites)
+	}
+}
+
+func TestGCSBackend_RetryUnavailable(t *testing.T) {
+	f, srv := newFakeGCS(t)
+	defer func(n int, d time.Duration) { chunkSize, retryDelay = n, d }(chunkSize, retryDelay)
+	chunkSize = 4
+	retryDelay = time.Millisecond
+	f.unavailable = 2
+
+	b, _ := New(context.Background(), Config{Bucket: "backups", Endpoint: srv.URL})
+	meta, err := b.Upload(context.Background(), "sonarr", "sonarr_2026-02-06T120000Z.zip", strings.NewReader("0123456789"), 10)
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+	if string(f.objects[meta.Key]) != "0123456789" || len(f.sessions) != 1 {
+		t.Errorf("stored %q in %d sessions", f.objects[meta.Key], len(f.sessions))
+	}
+
+	// A server that stays unavailable fails the upload.
+	f.unavailable = maxChunkRetries + 1
+	if _, err := b.Upload(context.Background(), "sonarr", "sonarr_2026-02-07T120000Z.zip", strings.NewReader("0123456789"), 10); err == nil || !strings.Contains(err.Error(), "503") {
+		t.Errorf("Upload with server down = %v, want 503 error", err)
+	}
+}
+
+// TestGCSBackend_FakeGCSServer runs against fake-gcs-server, e.g.
+// docker run -p 4443:4443 fsouza/fake-gcs-server -scheme http
+func TestGCSBackend_FakeGCSServer(t *testing.T) {
+	if os.Getenv("GCS_TEST") == "" {
+		t.Skip("GCS_TEST not set, skipping GCS integration tests")
+	}
+	const endpoint = "http://localhost:4443"
+	resp, err := http.Post(endpoint+"/storage/v1/b", "application/json", strings.NewReader(`{"name":"backuparr-test"}`))
+	if err != nil {
+		t.Fatalf("failed to create test bucket: %v", err)
+	}
+	resp.Body.Close()
+
+	b, err := New(context.Background(), Config{
+		Bucket:   "backuparr-test",
+		Prefix:   fmt.Sprintf("test-%d", time.Now().UnixNano()),
+		Endpoint: endpoint,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testRoundTrip(t, b)
+}
+
+func TestGCSBackend_ConfigValidation(t *testing.T) {
+	ctx := context.Background()
+	b, err := New(ctx, Config{Bucket: "backups", Endpoint: "http://localhost:4443"})
+	if err != nil {
+		t.Fatalf("valid config: %v", err)
+	}
+	if b.Type() != "gcs" || b.Name() != "gcs" || b.prefix != "backuparr" {
+		t.Errorf("backend = %+v", b)
+	}
+
+	for name, cfg := range map[string]Config{
+		"no bucket":        {Endpoint: "http://localhost:4443"},
+		"bad class":        {Bucket: "backups", Endpoint: "http://localhost:4443", StorageClass: "GLACIER"},
+		"bad credentials":  {Bucket: "backups", CredentialsJSON: "{"},
+		"missing key file": {Bucket: "backups", CredentialsFile: "/nonexistent.json"},
+	} {
+		if _, err := New(ctx, cfg); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
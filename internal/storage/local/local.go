@@ -7,7 +7,6 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
-	"strings"
 
 	"backuparr/internal/storage"
 )
@@ -59,10 +58,11 @@ func (b *LocalBackend) Upload(ctx context.Context, appName string, fileName stri
 	}
 
 	return &storage.BackupMetadata{
-		Key:      path,
-		AppName:  appName,
-		FileName: fileName,
-		Size:     written,
+		Key:       path,
+		AppName:   appName,
+		FileName:  fileName,
+		Size:      written,
+		Encrypted: storage.IsEncryptedName(fileName),
 	}, nil
 }
 
@@ -84,12 +84,14 @@ func (b *LocalBackend) Download(ctx context.Context, key string) (io.ReadCloser,
 		FileName:  filepath.Base(key),
 		Size:      info.Size(),
 		CreatedAt: info.ModTime(),
+		Encrypted: storage.IsEncryptedName(key),
 	}
 
 	return file, meta, nil
 }
 
-// List returns all backup files for an app, sorted newest-first by modification time.
+// List returns all backup files for an app, sorted newest-first by creation
+// time (from the manifest, falling back to modification time).
 func (b *LocalBackend) List(ctx context.Context, appName string) ([]storage.BackupMetadata, error) {
 	dir := filepath.Join(b.basePath, appName)
 	entries, err := os.ReadDir(dir)
@@ -101,23 +103,38 @@ func (b *LocalBackend) List(ctx context.Context, appName string) ([]storage.Back
 	}
 
 	var backups []storage.BackupMetadata
+	manifests := make(map[string]bool)
 	for _, entry := range entries {
-		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".zip") {
+		if entry.IsDir() {
+			continue
+		}
+		if storage.IsManifestName(entry.Name()) {
+			manifests[entry.Name()] = true
+			continue
+		}
+		if !storage.IsBackupFile(entry.Name()) {
 			continue
 		}
 		info, err := entry.Info()
 		if err != nil {
 			continue
 		}
-		backups = append(backups, storage.BackupMetadata{
+		meta := storage.BackupMetadata{
 			Key:       filepath.Join(dir, entry.Name()),
 			AppName:   appName,
 			FileName:  entry.Name(),
 			Size:      info.Size(),
 			CreatedAt: info.ModTime(),
-		})
+			Encrypted: storage.IsEncryptedName(entry.Name()),
+		}
+		backups = append(backups, meta)
 	}
 
+	// Manifests are authoritative: file mtimes change when backups are copied.
+	storage.ApplyManifests(backups, manifests, func(meta *storage.BackupMetadata) (io.ReadCloser, error) {
+		return os.Open(storage.ManifestKey(meta.Key))
+	})
+
 	// Sort newest-first
 	sort.Slice(backups, func(i, j int) bool {
 		return backups[i].CreatedAt.After(backups[j].CreatedAt)
@@ -0,0 +1,56 @@
+package local
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"backuparr/internal/storage"
+)
+
+func TestList_ManifestIsAuthoritative(t *testing.T) {
+	ctx := context.Background()
+	b := New(t.TempDir())
+
+	meta, err := b.Upload(ctx, "sonarr", "sonarr_2026-02-06T030000Z.zip", strings.NewReader("zip"), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	created := time.Date(2026, 2, 6, 3, 0, 0, 0, time.UTC)
+	if err := storage.WriteManifest(ctx, b, meta, storage.Manifest{CreatedAt: created, SHA256: "abc", Size: 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate a copy that reset the file's mtime.
+	if err := os.Chtimes(meta.Key, time.Now(), time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	backups, err := b.List(ctx, "sonarr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 1 {
+		t.Fatalf("List returned %d backups, want 1 (manifest must not be listed)", len(backups))
+	}
+	if !backups[0].CreatedAt.Equal(created) || backups[0].Checksum != "abc" {
+		t.Errorf("backup = %+v, want CreatedAt %v from manifest", backups[0], created)
+	}
+
+	m, err := storage.ReadManifest(ctx, b, meta.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.FileName != meta.FileName || m.Format == 0 {
+		t.Errorf("manifest = %+v", m)
+	}
+
+	if err := storage.DeleteBackup(ctx, b, meta.Key); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(storage.ManifestKey(meta.Key)); !os.IsNotExist(err) {
+		t.Error("manifest not deleted with backup")
+	}
+}
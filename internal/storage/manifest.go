@@ -0,0 +1,188 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+	"time"
+)
+
+// ManifestSuffix is appended to a stored backup's file name to form the name
+// of its manifest (e.g. "sonarr_...zip.manifest.json").
+const ManifestSuffix = ".manifest.json"
+
+// manifestFormat is the current manifest schema version.
+const manifestFormat = 1
+
+// Manifest is a small JSON document stored next to every backup. It is the
+// authoritative source of a backup's metadata: unlike file mtimes or S3
+// LastModified it survives copying backups between locations, and its
+// checksum lets a backup be verified before it is restored.
+//
+// Size and SHA256 describe the plaintext archive, i.e. what a client's
+// Restore receives, even when the stored object is encrypted.
+type Manifest struct {
+	Format           int       `json:"format"`
+	AppName          string    `json:"appName"`
+	AppType          string    `json:"appType"`
+	AppVersion       string    `json:"appVersion,omitempty"`
+	DBType           string    `json:"dbType,omitempty"`
+	FileName         string    `json:"fileName"`
+	CreatedAt        time.Time `json:"createdAt"`
+	Size             int64     `json:"size"`   // size of the backup archive in bytes
+	SHA256           string    `json:"sha256"` // hex SHA-256 of the backup archive
+	Encrypted        bool      `json:"encrypted,omitempty"`
+	BackuparrVersion string    `json:"backuparrVersion,omitempty"`
+	SourceURL        string    `json:"sourceUrl,omitempty"`
+}
+
+// ManifestKey returns the storage key of the manifest for a backup key.
+func ManifestKey(backupKey string) string {
+	return backupKey + ManifestSuffix
+}
+
+// IsManifestName reports whether a stored file name is a backup manifest.
+func IsManifestName(name string) bool {
+	return strings.HasSuffix(name, ManifestSuffix)
+}
+
+// Apply copies the authoritative fields of m onto meta.
+func (m *Manifest) Apply(meta *BackupMetadata) {
+	if !m.CreatedAt.IsZero() {
+		meta.CreatedAt = m.CreatedAt
+	}
+	meta.Checksum = m.SHA256
+}
+
+// DecodeManifest parses a manifest document.
+func DecodeManifest(r io.Reader) (*Manifest, error) {
+	var m Manifest
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	return &m, nil
+}
+
+// WriteManifest stores m next to the backup described by meta.
+func WriteManifest(ctx context.Context, backend Backend, meta *BackupMetadata, m Manifest) error {
+	m.Format = manifestFormat
+	m.FileName = meta.FileName
+	m.Encrypted = meta.Encrypted
+
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	if _, err := backend.Upload(ctx, meta.AppName, meta.FileName+ManifestSuffix, bytes.NewReader(data), int64(len(data))); err != nil {
+		return fmt.Errorf("failed to upload manifest: %w", err)
+	}
+	return nil
+}
+
+// ReadManifest fetches the manifest for a backup key. Backups created before
+// manifests were introduced have none, which is reported as an error
+// wrapping ErrNotFound; any other error means the manifest couldn't be read
+// and the backup can't be verified.
+func ReadManifest(ctx context.Context, backend Backend, backupKey string) (*Manifest, error) {
+	rc, _, err := backend.Download(ctx, ManifestKey(backupKey))
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return DecodeManifest(rc)
+}
+
+// ApplyManifests overrides the metadata of backups from a listing with
+// their manifests, which are authoritative over mtimes and object or
+// snapshot times since those change when backups are copied. listed holds
+// the manifest file names seen in the same listing: only those manifests
+// are read, through open, so backups without one cost nothing. Failures are
+// ignored so a missing or corrupt manifest never hides a backup.
+func ApplyManifests(backups []BackupMetadata, listed map[string]bool, open func(backup *BackupMetadata) (io.ReadCloser, error)) {
+	for i := range backups {
+		if !listed[backups[i].FileName+ManifestSuffix] {
+			continue
+		}
+		rc, err := open(&backups[i])
+		if err != nil {
+			continue
+		}
+		m, err := DecodeManifest(rc)
+		rc.Close()
+		if err == nil {
+			m.Apply(&backups[i])
+		}
+	}
+}
+
+// DeleteBackup removes a backup and, best-effort, its manifest.
+func DeleteBackup(ctx context.Context, backend Backend, key string) error {
+	if err := backend.Delete(ctx, key); err != nil {
+		return err
+	}
+	// Older backups have no manifest; ignore failures here.
+	_ = backend.Delete(ctx, ManifestKey(key))
+	return nil
+}
+
+// ErrChecksumMismatch is returned when a backup does not match its manifest.
+var ErrChecksumMismatch = errors.New("backup checksum does not match manifest")
+
+// Hasher computes the size and SHA-256 of a stream as it is read.
+type Hasher struct {
+	r    io.Reader
+	h    hash.Hash
+	size int64
+}
+
+// NewHasher returns a reader that hashes everything read through it.
+func NewHasher(r io.Reader) *Hasher {
+	return &Hasher{r: r, h: sha256.New()}
+}
+
+func (h *Hasher) Read(p []byte) (int, error) {
+	n, err := h.r.Read(p)
+	h.h.Write(p[:n])
+	h.size += int64(n)
+	return n, err
+}
+
+// Size returns the number of bytes read so far.
+func (h *Hasher) Size() int64 { return h.size }
+
+// Sum returns the hex SHA-256 of the bytes read so far.
+func (h *Hasher) Sum() string { return hex.EncodeToString(h.h.Sum(nil)) }
+
+// verifyingReader checks a stream against a manifest once it reaches EOF.
+type verifyingReader struct {
+	*Hasher
+	want *Manifest
+}
+
+// NewVerifyingReader returns a reader that fails with ErrChecksumMismatch at
+// EOF if the data read does not match the size and checksum in m. Clients
+// that read the whole archive before acting on it (all current ones) will
+// therefore refuse to restore a corrupted backup.
+func NewVerifyingReader(r io.Reader, m *Manifest) io.Reader {
+	return &verifyingReader{Hasher: NewHasher(r), want: m}
+}
+
+func (v *verifyingReader) Read(p []byte) (int, error) {
+	n, err := v.Hasher.Read(p)
+	if err == io.EOF {
+		if v.Size() != v.want.Size {
+			return n, fmt.Errorf("%w: size %d, want %d", ErrChecksumMismatch, v.Size(), v.want.Size)
+		}
+		if sum := v.Sum(); sum != v.want.SHA256 {
+			return n, fmt.Errorf("%w: sha256 %s, want %s", ErrChecksumMismatch, sum, v.want.SHA256)
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,141 @@
+package storage
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHasher(t *testing.T) {
+	data := []byte("backuparr manifest test")
+	h := NewHasher(bytes.NewReader(data))
+	if _, err := io.Copy(io.Discard, h); err != nil {
+		t.Fatal(err)
+	}
+
+	sum := sha256.Sum256(data)
+	if got, want := h.Sum(), hex.EncodeToString(sum[:]); got != want {
+		t.Errorf("Sum() = %s, want %s", got, want)
+	}
+	if h.Size() != int64(len(data)) {
+		t.Errorf("Size() = %d, want %d", h.Size(), len(data))
+	}
+}
+
+func TestCountingReader(t *testing.T) {
+	c := &CountingReader{R: strings.NewReader("backuparr")}
+	if _, err := io.Copy(io.Discard, c); err != nil {
+		t.Fatal(err)
+	}
+	if c.N != 9 {
+		t.Errorf("N = %d, want 9", c.N)
+	}
+}
+
+func TestVerifyingReader(t *testing.T) {
+	data := []byte("sonarr backup contents")
+	sum := sha256.Sum256(data)
+	m := &Manifest{Size: int64(len(data)), SHA256: hex.EncodeToString(sum[:])}
+
+	got, err := io.ReadAll(NewVerifyingReader(bytes.NewReader(data), m))
+	if err != nil {
+		t.Fatalf("valid backup: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Error("data mismatch")
+	}
+
+	corrupt := append([]byte{}, data...)
+	corrupt[0] ^= 0xff
+	if _, err := io.ReadAll(NewVerifyingReader(bytes.NewReader(corrupt), m)); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("corrupt backup: err = %v, want ErrChecksumMismatch", err)
+	}
+
+	if _, err := io.ReadAll(NewVerifyingReader(bytes.NewReader(data[:5]), m)); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("truncated backup: err = %v, want ErrChecksumMismatch", err)
+	}
+}
+
+func TestManifestApply(t *testing.T) {
+	created := time.Date(2026, 2, 6, 3, 0, 0, 0, time.UTC)
+	m, err := DecodeManifest(strings.NewReader(`{"format":1,"createdAt":"2026-02-06T03:00:00Z","sha256":"abc"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta := BackupMetadata{CreatedAt: time.Now()}
+	m.Apply(&meta)
+	if !meta.CreatedAt.Equal(created) || meta.Checksum != "abc" {
+		t.Errorf("meta = %+v, want CreatedAt %v and checksum abc", meta, created)
+	}
+}
+
+func TestApplyManifests(t *testing.T) {
+	objects := map[string]string{
+		"a.zip.manifest.json": `{"format":1,"createdAt":"2026-02-06T03:00:00Z","sha256":"abc"}`,
+		"b.zip.manifest.json": `{`,
+		"c.zip.manifest.json": `{"format":1,"sha256":"unlisted"}`,
+	}
+	mtime := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	backups := []BackupMetadata{
+		{FileName: "a.zip", CreatedAt: mtime},
+		{FileName: "b.zip", CreatedAt: mtime}, // corrupt manifest
+		{FileName: "c.zip", CreatedAt: mtime}, // manifest not in the listing
+		{FileName: "d.zip", CreatedAt: mtime}, // listed but unreadable
+	}
+	listed := map[string]bool{"a.zip.manifest.json": true, "b.zip.manifest.json": true, "d.zip.manifest.json": true}
+
+	var opened []string
+	ApplyManifests(backups, listed, func(meta *BackupMetadata) (io.ReadCloser, error) {
+		name := meta.FileName + ManifestSuffix
+		opened = append(opened, name)
+		data, ok := objects[name]
+		if !ok {
+			return nil, fmt.Errorf("%s: %w", name, ErrNotFound)
+		}
+		return io.NopCloser(strings.NewReader(data)), nil
+	})
+
+	if want := time.Date(2026, 2, 6, 3, 0, 0, 0, time.UTC); !backups[0].CreatedAt.Equal(want) || backups[0].Checksum != "abc" {
+		t.Errorf("backups[0] = %+v, want manifest applied", backups[0])
+	}
+	for _, meta := range backups[1:] {
+		if !meta.CreatedAt.Equal(mtime) || meta.Checksum != "" {
+			t.Errorf("%s = %+v, want unchanged", meta.FileName, meta)
+		}
+	}
+	if len(opened) != 3 {
+		t.Errorf("opened %v, want only the listed manifests", opened)
+	}
+}
+
+func TestBackupNames(t *testing.T) {
+	tests := []struct {
+		name      string
+		backup    bool
+		encrypted bool
+		manifest  bool
+	}{
+		{"sonarr_2026-02-06T030000Z.zip", true, false, false},
+		{"sonarr_2026-02-06T030000Z.zip.age", true, true, false},
+		{"sonarr_2026-02-06T030000Z.zip.manifest.json", false, false, true},
+		{"sonarr_2026-02-06T030000Z.zip.age.manifest.json", false, false, true},
+	}
+	for _, tt := range tests {
+		if got := IsBackupFile(tt.name); got != tt.backup {
+			t.Errorf("IsBackupFile(%q) = %v, want %v", tt.name, got, tt.backup)
+		}
+		if got := IsEncryptedName(tt.name); got != tt.encrypted {
+			t.Errorf("IsEncryptedName(%q) = %v, want %v", tt.name, got, tt.encrypted)
+		}
+		if got := IsManifestName(tt.name); got != tt.manifest {
+			t.Errorf("IsManifestName(%q) = %v, want %v", tt.name, got, tt.manifest)
+		}
+	}
+}
@@ -0,0 +1,519 @@
+// Package pbs implements a storage backend on a Proxmox Backup Server
+// datastore by running proxmox-backup-client (2.2 or newer, for namespaces).
+//
+// Each app maps to the backup group host/<app> in the configured namespace
+// and every backup becomes a snapshot in that group holding one pxar
+// archive, so PBS deduplicates consecutive backups with its content-defined
+// chunking and verify jobs cover them like any other snapshot. The snapshot
+// path (e.g. "host/sonarr/2026-02-06T12:00:00Z") is the key.
+//
+// proxmox-backup-client can't read an archive from stdin, so uploads are
+// spooled to a temporary directory first. The backup file name is kept as
+// the first line of the snapshot notes, which PBS shows as its comment, and
+// the manifest follows it in the notes instead of being stored separately.
+// Notes take a client call per snapshot to read, so List relies on snapshot
+// times, which sync jobs preserve, and manifests are only read on Download.
+// Deleting forgets the snapshot; space is reclaimed by the datastore's
+// garbage collection job on the server.
+package pbs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"backuparr/internal/storage"
+)
+
+// Ensure PBSBackend implements storage.Backend at compile time.
+var _ storage.Backend = (*PBSBackend)(nil)
+
+// Config holds the configuration for a Proxmox Backup Server backend.
+type Config struct {
+	Host string
+	Port int // default 8007
+	// User is a PBS user such as "backup@pbs", or an API token such as
+	// "backup@pbs!backuparr" whose secret is then given as Password.
+	User      string
+	Password  string
+	Datastore string
+	Namespace string // optional, e.g. "apps" or "homelab/apps"
+	// Fingerprint pins the server's TLS certificate, as shown on the PBS
+	// dashboard. Required unless the certificate is trusted by the system.
+	Fingerprint string
+	// Binary is the client executable, defaults to "proxmox-backup-client"
+	// on PATH.
+	Binary string
+}
+
+// PBSBackend stores backups as snapshots on a Proxmox Backup Server.
+type PBSBackend struct {
+	binary    string
+	env       []string
+	namespace string
+	name      string
+}
+
+const (
+	// backupType is the PBS backup type of every group backuparr writes.
+	backupType = "host"
+	// archiveName is the pxar archive each snapshot holds; PBS stores it
+	// as archiveName+".didx".
+	archiveName = "backuparr.pxar"
+	// timeFormat is how PBS formats backup times in snapshot paths.
+	timeFormat = "2006-01-02T15:04:05Z"
+)
+
+// New creates a new PBS storage backend from the given config.
+func New(cfg Config) (*PBSBackend, error) {
+	if cfg.Host == "" {
+		return nil, fmt.Errorf("pbs: host is required")
+	}
+	if cfg.Datastore == "" {
+		return nil, fmt.Errorf("pbs: datastore is required")
+	}
+	if cfg.User == "" || cfg.Password == "" {
+		return nil, fmt.Errorf("pbs: user and password are required")
+	}
+	binary := cfg.Binary
+	if binary == "" {
+		binary = "proxmox-backup-client"
+	}
+
+	env := append(os.Environ(),
+		"PBS_REPOSITORY="+repository(cfg),
+		"PBS_PASSWORD="+cfg.Password,
+	)
+	if cfg.Fingerprint != "" {
+		env = append(env, "PBS_FINGERPRINT="+cfg.Fingerprint)
+	}
+
+	return &PBSBackend{
+		binary:    binary,
+		env:       env,
+		namespace: strings.Trim(cfg.Namespace, "/"),
+	}, nil
+}
+
+// repository builds the client's repository string,
+// user@realm@host:port:datastore.
+func repository(cfg Config) string {
+	host := cfg.Host
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	port := cfg.Port
+	if port == 0 {
+		port = 8007
+	}
+	return fmt.Sprintf("%s@%s:%d:%s", cfg.User, host, port, cfg.Datastore)
+}
+
+func (b *PBSBackend) Type() string { return "pbs" }
+
+func (b *PBSBackend) Name() string {
+	if b.name != "" {
+		return b.name
+	}
+	return b.Type()
+}
+
+func (b *PBSBackend) SetName(name string) { b.name = name }
+
+// command prepares a client invocation in the configured namespace.
+func (b *PBSBackend) command(ctx context.Context, args ...string) (*exec.Cmd, *bytes.Buffer) {
+	if b.namespace != "" {
+		args = append(args, "--ns", b.namespace)
+	}
+	cmd := exec.CommandContext(ctx, b.binary, args...)
+	cmd.Env = b.env
+	cmd.Cancel = func() error { return cmd.Process.Signal(os.Interrupt) }
+	cmd.WaitDelay = 30 * time.Second
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	return cmd, &stderr
+}
+
+// run runs the client to completion and returns its standard output.
+func (b *PBSBackend) run(ctx context.Context, args ...string) ([]byte, error) {
+	cmd, stderr := b.command(ctx, args...)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, commandError(args, err, stderr)
+	}
+	return out, nil
+}
+
+func commandError(args []string, err error, stderr *bytes.Buffer) error {
+	subcommand := args[0]
+	if subcommand == "snapshot" && len(args) > 1 {
+		subcommand += " " + args[1]
+	}
+	msg := strings.TrimSpace(stderr.String())
+	if msg == "" {
+		return fmt.Errorf("proxmox-backup-client %s failed: %w", subcommand, err)
+	}
+	return fmt.Errorf("proxmox-backup-client %s failed: %w - %s", subcommand, err, msg)
+}
+
+// snapshotPath formats the path of an app's snapshot taken at t.
+func snapshotPath(appName string, t time.Time) string {
+	return backupType + "/" + appName + "/" + t.UTC().Format(timeFormat)
+}
+
+// parseKey splits a snapshot path into its app name and backup time.
+func parseKey(key string) (appName string, t time.Time, err error) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 3 || parts[0] != backupType || parts[1] == "" {
+		return "", time.Time{}, fmt.Errorf("pbs: invalid snapshot %q", key)
+	}
+	t, err = time.Parse(time.RFC3339, parts[2])
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("pbs: invalid snapshot %q: %w", key, err)
+	}
+	return parts[1], t, nil
+}
+
+// snapshot is the subset of `snapshot list --output-format json` output
+// used here.
+type snapshot struct {
+	BackupType string `json:"backup-type"`
+	BackupID   string `json:"backup-id"`
+	BackupTime int64  `json:"backup-time"`
+	// Comment is the first line of the snapshot notes.
+	Comment string `json:"comment"`
+	Files   []struct {
+		Filename string `json:"filename"`
+		Size     int64  `json:"size"`
+	} `json:"files"`
+}
+
+func (s *snapshot) path() string {
+	return snapshotPath(s.BackupID, time.Unix(s.BackupTime, 0))
+}
+
+// archiveSize returns the size of the snapshot's backuparr archive, or -1
+// if it has none.
+func (s *snapshot) archiveSize() int64 {
+	for _, f := range s.Files {
+		if f.Filename == archiveName+".didx" {
+			return f.Size
+		}
+	}
+	return -1
+}
+
+func parseSnapshots(data []byte) ([]snapshot, error) {
+	var snaps []snapshot
+	if err := json.Unmarshal(data, &snaps); err != nil {
+		return nil, fmt.Errorf("pbs: failed to parse snapshots: %w", err)
+	}
+	return snaps, nil
+}
+
+// namespaceSnapshots lists all snapshots in the namespace. Listing a single
+// group fails until its first backup, so callers filter by group instead.
+func (b *PBSBackend) namespaceSnapshots(ctx context.Context) ([]snapshot, error) {
+	out, err := b.run(ctx, "snapshot", "list", "--output-format", "json")
+	if err != nil {
+		return nil, err
+	}
+	return parseSnapshots(out)
+}
+
+// backupsFromSnapshots returns an app's backups among the snapshots of a
+// namespace. Other groups, and snapshots written into the app's group by
+// other tools, are skipped.
+func backupsFromSnapshots(snaps []snapshot, appName string) []storage.BackupMetadata {
+	var backups []storage.BackupMetadata
+	for i := range snaps {
+		snap := &snaps[i]
+		size := snap.archiveSize()
+		if snap.BackupType != backupType || snap.BackupID != appName || size < 0 || !storage.IsBackupFile(snap.Comment) {
+			continue
+		}
+		backups = append(backups, storage.BackupMetadata{
+			Key:       snap.path(),
+			AppName:   appName,
+			FileName:  snap.Comment,
+			Size:      size,
+			CreatedAt: time.Unix(snap.BackupTime, 0).UTC(),
+			Encrypted: storage.IsEncryptedName(snap.Comment),
+		})
+	}
+	return backups
+}
+
+// notes returns a snapshot's file name and manifest, which is empty if none
+// was written.
+func (b *PBSBackend) notes(ctx context.Context, key string) (fileName, manifest string, err error) {
+	out, err := b.run(ctx, "snapshot", "notes", "show", key)
+	if err != nil {
+		return "", "", err
+	}
+	fileName, manifest = splitNotes(string(out))
+	return fileName, manifest, nil
+}
+
+// formatNotes and splitNotes convert between a backup's file name and
+// manifest and the snapshot notes: the file name on the first line, so it
+// becomes the snapshot comment, then the manifest after a blank line.
+func formatNotes(fileName, manifest string) string {
+	if manifest == "" {
+		return fileName
+	}
+	return fileName + "\n\n" + manifest
+}
+
+func splitNotes(notes string) (fileName, manifest string) {
+	fileName, manifest, _ = strings.Cut(notes, "\n")
+	return strings.TrimSpace(fileName), strings.TrimSpace(manifest)
+}
+
+// Upload stores data as a new snapshot in the app's backup group. A
+// manifest is added to the notes of the snapshot holding its backup instead.
+func (b *PBSBackend) Upload(ctx context.Context, appName string, fileName string, data io.Reader, size int64) (*storage.BackupMetadata, error) {
+	if storage.IsManifestName(fileName) {
+		return b.uploadManifest(ctx, appName, fileName, data)
+	}
+
+	// The archive is a directory holding just the backup file, so that
+	// it restores under its own name.
+	dir, err := os.MkdirTemp("", "backuparr-pbs-")
+	if err != nil {
+		return nil, fmt.Errorf("pbs: failed to create spool directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	src := filepath.Join(dir, "archive")
+	if err := os.Mkdir(src, 0o700); err != nil {
+		return nil, fmt.Errorf("pbs: failed to create spool directory: %w", err)
+	}
+	written, err := spool(filepath.Join(src, fileName), data)
+	if err != nil {
+		return nil, err
+	}
+
+	snaps, err := b.namespaceSnapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pbs: failed to list snapshots: %w", err)
+	}
+	createdAt := nextBackupTime(snaps, appName, time.Now())
+	key := snapshotPath(appName, createdAt)
+	cmd, stderr := b.command(ctx, "backup", archiveName+":"+src,
+		"--backup-type", backupType,
+		"--backup-id", appName,
+		"--backup-time", strconv.FormatInt(createdAt.Unix(), 10))
+	if err := cmd.Run(); err != nil {
+		return nil, commandError(cmd.Args[1:], err, stderr)
+	}
+	if _, err := b.run(ctx, "snapshot", "notes", "update", key, formatNotes(fileName, "")); err != nil {
+		// Without its file name the snapshot is invisible to List, so
+		// don't leave it behind.
+		b.run(ctx, "snapshot", "forget", key)
+		return nil, fmt.Errorf("pbs: failed to record file name of %s: %w", key, err)
+	}
+
+	return &storage.BackupMetadata{
+		Key:       key,
+		AppName:   appName,
+		FileName:  fileName,
+		Size:      written,
+		CreatedAt: createdAt,
+		Encrypted: storage.IsEncryptedName(fileName),
+	}, nil
+}
+
+// nextBackupTime returns the time for a new snapshot of an app: now, to the
+// second, or one second after the app's newest snapshot if that is not
+// older. Snapshot times only have second resolution, and two uploads within
+// one second must not share a snapshot.
+func nextBackupTime(snaps []snapshot, appName string, now time.Time) time.Time {
+	t := now.UTC().Truncate(time.Second)
+	for _, snap := range snaps {
+		if snap.BackupType == backupType && snap.BackupID == appName && snap.BackupTime >= t.Unix() {
+			t = time.Unix(snap.BackupTime+1, 0).UTC()
+		}
+	}
+	return t
+}
+
+// spool copies data to a new file at name.
+func spool(name string, data io.Reader) (int64, error) {
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return 0, fmt.Errorf("pbs: failed to create spool file: %w", err)
+	}
+	written, err := io.Copy(f, data)
+	if err != nil {
+		f.Close()
+		return 0, fmt.Errorf("pbs: failed to spool backup: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return 0, fmt.Errorf("pbs: failed to spool backup: %w", err)
+	}
+	return written, nil
+}
+
+// uploadManifest stores a manifest in the notes of the newest snapshot
+// holding its backup.
+func (b *PBSBackend) uploadManifest(ctx context.Context, appName string, fileName string, data io.Reader) (*storage.BackupMetadata, error) {
+	manifest, err := io.ReadAll(data)
+	if err != nil {
+		return nil, fmt.Errorf("pbs: failed to read manifest: %w", err)
+	}
+	backupName := strings.TrimSuffix(fileName, storage.ManifestSuffix)
+
+	snaps, err := b.namespaceSnapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pbs: failed to list snapshots: %w", err)
+	}
+	var target *storage.BackupMetadata
+	for _, backup := range backupsFromSnapshots(snaps, appName) {
+		if backup.FileName == backupName && (target == nil || backup.CreatedAt.After(target.CreatedAt)) {
+			target = &backup
+		}
+	}
+	if target == nil {
+		return nil, fmt.Errorf("pbs: no snapshot holds %s", backupName)
+	}
+
+	notes := formatNotes(backupName, strings.TrimSpace(string(manifest)))
+	if _, err := b.run(ctx, "snapshot", "notes", "update", target.Key, notes); err != nil {
+		return nil, fmt.Errorf("pbs: failed to store manifest of %s: %w", target.Key, err)
+	}
+	return &storage.BackupMetadata{
+		Key:       storage.ManifestKey(target.Key),
+		AppName:   appName,
+		FileName:  fileName,
+		Size:      int64(len(manifest)),
+		CreatedAt: target.CreatedAt,
+	}, nil
+}
+
+// restoredFile is a backup extracted from its snapshot into a temporary
+// directory, which is removed on Close.
+type restoredFile struct {
+	*os.File
+	dir string
+}
+
+func (f *restoredFile) Close() error {
+	err := f.File.Close()
+	os.RemoveAll(f.dir)
+	return err
+}
+
+// Download restores a backup by its snapshot path, or the manifest of that
+// backup for its storage.ManifestKey. Caller must close the reader.
+func (b *PBSBackend) Download(ctx context.Context, key string) (io.ReadCloser, *storage.BackupMetadata, error) {
+	if storage.IsManifestName(key) {
+		return b.downloadManifest(ctx, key)
+	}
+	appName, createdAt, err := parseKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "backuparr-pbs-")
+	if err != nil {
+		return nil, nil, fmt.Errorf("pbs: failed to create restore directory: %w", err)
+	}
+	target := filepath.Join(dir, "archive")
+	if _, err := b.run(ctx, "restore", key, archiveName, target); err != nil {
+		os.RemoveAll(dir)
+		return nil, nil, fmt.Errorf("pbs: failed to restore %s: %w", key, err)
+	}
+
+	entries, err := os.ReadDir(target)
+	if err != nil || len(entries) != 1 || !entries[0].Type().IsRegular() {
+		os.RemoveAll(dir)
+		return nil, nil, fmt.Errorf("pbs: snapshot %s does not hold a backuparr backup", key)
+	}
+	f, err := os.Open(filepath.Join(target, entries[0].Name()))
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, nil, fmt.Errorf("pbs: failed to open restored backup: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		os.RemoveAll(dir)
+		return nil, nil, fmt.Errorf("pbs: failed to stat restored backup: %w", err)
+	}
+
+	meta := &storage.BackupMetadata{
+		Key:       key,
+		AppName:   appName,
+		FileName:  entries[0].Name(),
+		Size:      info.Size(),
+		CreatedAt: createdAt,
+		Encrypted: storage.IsEncryptedName(entries[0].Name()),
+	}
+	return &restoredFile{File: f, dir: dir}, meta, nil
+}
+
+// downloadManifest returns the manifest kept in a snapshot's notes.
+func (b *PBSBackend) downloadManifest(ctx context.Context, key string) (io.ReadCloser, *storage.BackupMetadata, error) {
+	snapKey := strings.TrimSuffix(key, storage.ManifestSuffix)
+	appName, createdAt, err := parseKey(snapKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	fileName, manifest, err := b.notes(ctx, snapKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("pbs: failed to read notes of %s: %w", snapKey, err)
+	}
+	if manifest == "" {
+		return nil, nil, fmt.Errorf("pbs: manifest not found: %s: %w", key, storage.ErrNotFound)
+	}
+	meta := &storage.BackupMetadata{
+		Key:       key,
+		AppName:   appName,
+		FileName:  fileName + storage.ManifestSuffix,
+		Size:      int64(len(manifest)),
+		CreatedAt: createdAt,
+	}
+	return io.NopCloser(strings.NewReader(manifest)), meta, nil
+}
+
+// List returns all backups for an app, sorted newest-first by snapshot time.
+// It costs a single snapshot listing: manifests are left in the notes.
+func (b *PBSBackend) List(ctx context.Context, appName string) ([]storage.BackupMetadata, error) {
+	snaps, err := b.namespaceSnapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pbs: failed to list snapshots: %w", err)
+	}
+
+	backups := backupsFromSnapshots(snaps, appName)
+
+	// Sort newest-first
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.After(backups[j].CreatedAt)
+	})
+
+	return backups, nil
+}
+
+// Delete forgets a backup's snapshot. Its manifest lives in the snapshot
+// notes and goes with it, so deleting a manifest key is a no-op.
+func (b *PBSBackend) Delete(ctx context.Context, key string) error {
+	if storage.IsManifestName(key) {
+		return nil
+	}
+	if _, _, err := parseKey(key); err != nil {
+		return err
+	}
+	if _, err := b.run(ctx, "snapshot", "forget", key); err != nil {
+		return fmt.Errorf("pbs: failed to forget %s: %w", key, err)
+	}
+	return nil
+}
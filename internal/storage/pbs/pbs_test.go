@@ -0,0 +1,200 @@
+package pbs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"backuparr/internal/storage"
+)
+
+func TestNew_Validation(t *testing.T) {
+	valid := Config{Host: "pbs.lan", User: "backup@pbs!backuparr", Password: "secret", Datastore: "tank"}
+	b, err := New(valid)
+	if err != nil {
+		t.Fatalf("valid config: %v", err)
+	}
+	if b.Type() != "pbs" || b.Name() != "pbs" {
+		t.Errorf("backend = %+v", b)
+	}
+
+	for name, mutate := range map[string]func(*Config){
+		"no host":      func(c *Config) { c.Host = "" },
+		"no datastore": func(c *Config) { c.Datastore = "" },
+		"no user":      func(c *Config) { c.User = "" },
+		"no password":  func(c *Config) { c.Password = "" },
+	} {
+		cfg := valid
+		mutate(&cfg)
+		if _, err := New(cfg); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestRepository(t *testing.T) {
+	for cfg, want := range map[Config]string{
+		{User: "backup@pbs", Host: "pbs.lan", Datastore: "tank"}:                    "backup@pbs@pbs.lan:8007:tank",
+		{User: "backup@pbs!backuparr", Host: "10.0.0.5", Port: 443, Datastore: "s"}: "backup@pbs!backuparr@10.0.0.5:443:s",
+		{User: "root@pam", Host: "fd00::5", Datastore: "tank"}:                      "root@pam@[fd00::5]:8007:tank",
+	} {
+		if got := repository(cfg); got != want {
+			t.Errorf("repository(%+v) = %q, want %q", cfg, got, want)
+		}
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	created := time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC)
+	key := snapshotPath("sonarr", created)
+	if key != "host/sonarr/2026-02-06T12:00:00Z" {
+		t.Errorf("snapshotPath = %q", key)
+	}
+	app, got, err := parseKey(key)
+	if err != nil || app != "sonarr" || !got.Equal(created) {
+		t.Errorf("parseKey = %q, %v, %v", app, got, err)
+	}
+	for _, bad := range []string{"sonarr_2026-02-06T120000Z.zip", "vm/100/2026-02-06T12:00:00Z", "host/sonarr/yesterday"} {
+		if _, _, err := parseKey(bad); err == nil {
+			t.Errorf("parseKey(%q): expected error", bad)
+		}
+	}
+}
+
+func TestBackupsFromSnapshots(t *testing.T) {
+	snaps, err := parseSnapshots([]byte(`[
+		{"backup-type":"host","backup-id":"sonarr","backup-time":1770379200,"comment":"sonarr_2026-02-06T120000Z.zip",
+		 "files":[{"filename":"backuparr.pxar.didx","size":500},{"filename":"index.json.blob","size":400}],"size":900},
+		{"backup-type":"host","backup-id":"sonarr","backup-time":1770292800,"comment":"sonarr_2026-02-05T120000Z.zip",
+		 "files":[{"filename":"backuparr.pxar.didx","size":300}]},
+		{"backup-type":"host","backup-id":"sonarr","backup-time":1770200000,"comment":"nightly",
+		 "files":[{"filename":"root.pxar.didx","size":1000}]},
+		{"backup-type":"host","backup-id":"radarr","backup-time":1770379200,"comment":"radarr_2026-02-06T120000Z.zip",
+		 "files":[{"filename":"backuparr.pxar.didx","size":200}]},
+		{"backup-type":"vm","backup-id":"sonarr","backup-time":1770379200,
+		 "files":[{"filename":"drive-scsi0.img.fidx","size":1000}]}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backups := backupsFromSnapshots(snaps, "sonarr")
+	if len(backups) != 2 {
+		t.Fatalf("backups = %+v", backups)
+	}
+	want := storage.BackupMetadata{
+		Key:       "host/sonarr/2026-02-06T12:00:00Z",
+		AppName:   "sonarr",
+		FileName:  "sonarr_2026-02-06T120000Z.zip",
+		Size:      500,
+		CreatedAt: time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC),
+	}
+	if backups[0] != want {
+		t.Errorf("backups[0] = %+v, want %+v", backups[0], want)
+	}
+}
+
+func TestNextBackupTime(t *testing.T) {
+	now := time.Date(2026, 2, 6, 12, 0, 0, 500, time.UTC)
+	snaps := []snapshot{
+		{BackupType: "host", BackupID: "radarr", BackupTime: now.Unix() + 5},
+		{BackupType: "host", BackupID: "sonarr", BackupTime: now.Unix() - 60},
+	}
+	if got, want := nextBackupTime(snaps, "sonarr", now), now.Truncate(time.Second); !got.Equal(want) {
+		t.Errorf("nextBackupTime = %v, want %v", got, want)
+	}
+
+	// A second upload within the same second gets the next second.
+	snaps = append(snaps, snapshot{BackupType: "host", BackupID: "sonarr", BackupTime: now.Unix()})
+	if got, want := nextBackupTime(snaps, "sonarr", now), now.Truncate(time.Second).Add(time.Second); !got.Equal(want) {
+		t.Errorf("nextBackupTime after same-second upload = %v, want %v", got, want)
+	}
+}
+
+func TestNotes(t *testing.T) {
+	notes := formatNotes("sonarr_2026-02-06T120000Z.zip", `{"format":1}`)
+	if fileName, manifest := splitNotes(notes + "\n"); fileName != "sonarr_2026-02-06T120000Z.zip" || manifest != `{"format":1}` {
+		t.Errorf("splitNotes(%q) = %q, %q", notes, fileName, manifest)
+	}
+	if fileName, manifest := splitNotes("sonarr_2026-02-06T120000Z.zip\n"); fileName != "sonarr_2026-02-06T120000Z.zip" || manifest != "" {
+		t.Errorf("splitNotes without manifest = %q, %q", fileName, manifest)
+	}
+}
+
+// TestRoundTrip runs against a real Proxmox Backup Server, e.g.
+// PBS_TEST=1 PBS_TEST_HOST=pbs.lan PBS_TEST_USER='backup@pbs!test'
+// PBS_TEST_PASSWORD=... PBS_TEST_DATASTORE=tank PBS_TEST_FINGERPRINT=...
+func TestRoundTrip(t *testing.T) {
+	if os.Getenv("PBS_TEST") == "" {
+		t.Skip("PBS_TEST not set, skipping PBS integration tests")
+	}
+	b, err := New(Config{
+		Host:        os.Getenv("PBS_TEST_HOST"),
+		User:        os.Getenv("PBS_TEST_USER"),
+		Password:    os.Getenv("PBS_TEST_PASSWORD"),
+		Datastore:   os.Getenv("PBS_TEST_DATASTORE"),
+		Namespace:   os.Getenv("PBS_TEST_NAMESPACE"),
+		Fingerprint: os.Getenv("PBS_TEST_FINGERPRINT"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	app := fmt.Sprintf("test-%d", time.Now().UnixNano())
+
+	older := storage.FormatBackupName(app, time.Date(2026, 2, 5, 12, 0, 0, 0, time.UTC))
+	newer := storage.FormatBackupName(app, time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC))
+	meta, err := b.Upload(ctx, app, older, strings.NewReader("old backup"), 10)
+	if err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+	if meta.Size != 10 {
+		t.Errorf("Upload meta = %+v", meta)
+	}
+	m := storage.Manifest{CreatedAt: time.Date(2026, 2, 5, 12, 0, 0, 0, time.UTC)}
+	if err := storage.WriteManifest(ctx, b, meta, m); err != nil {
+		t.Fatal(err)
+	}
+	// Snapshot times have second resolution; Upload moves the second
+	// upload past the first.
+	if _, err := b.Upload(ctx, app, newer, strings.NewReader("new backup"), 0); err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+
+	backups, err := b.List(ctx, app)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(backups) != 2 || backups[0].FileName != newer {
+		t.Fatalf("List = %+v", backups)
+	}
+	if backups[1].Key != meta.Key || !backups[1].CreatedAt.Equal(meta.CreatedAt) {
+		t.Errorf("List = %+v, want snapshot time of %+v", backups[1], meta)
+	}
+	if got, err := storage.ReadManifest(ctx, b, meta.Key); err != nil || !got.CreatedAt.Equal(m.CreatedAt) {
+		t.Errorf("ReadManifest = %+v, %v", got, err)
+	}
+
+	reader, dlMeta, err := b.Download(ctx, meta.Key)
+	if err != nil {
+		t.Fatalf("Download failed: %v", err)
+	}
+	data, _ := io.ReadAll(reader)
+	reader.Close()
+	if string(data) != "old backup" || dlMeta.AppName != app || dlMeta.FileName != older {
+		t.Errorf("Download = %q, %+v", data, dlMeta)
+	}
+
+	for _, backup := range backups {
+		if err := storage.DeleteBackup(ctx, b, backup.Key); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+	}
+	if backups, err := b.List(ctx, app); err != nil || len(backups) != 0 {
+		t.Errorf("List after delete = %+v, %v", backups, err)
+	}
+}
@@ -0,0 +1,480 @@
+// Package restic implements a storage backend on a restic repository by
+// running the restic CLI (0.16 or newer). Each backup is stored as its own
+// snapshot from stdin, tagged with the app name, so consecutive backups of
+// the same app deduplicate against each other. The snapshot ID is the key.
+//
+// Manifests are stored as small snapshots of their own next to the backup
+// and are forgotten together with it. Deleting only forgets snapshots; the
+// space is reclaimed by Prune, which retention runs after deleting.
+package restic
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"backuparr/internal/storage"
+)
+
+// Ensure ResticBackend implements storage.Backend and storage.Pruner at
+// compile time.
+var (
+	_ storage.Backend = (*ResticBackend)(nil)
+	_ storage.Pruner  = (*ResticBackend)(nil)
+)
+
+// Config holds the configuration for a restic repository backend.
+type Config struct {
+	// Repository is anything restic accepts for --repo: a local path,
+	// sftp:host:/path, s3:..., rest:..., and so on. Credentials for remote
+	// repositories are read from the environment as usual for restic.
+	Repository   string
+	Password     string
+	PasswordFile string
+	// Binary is the restic executable, defaults to "restic" on PATH.
+	Binary string
+}
+
+// ResticBackend stores backups as snapshots in a restic repository.
+type ResticBackend struct {
+	binary string
+	env    []string
+	name   string
+}
+
+// host is recorded on every snapshot so they are grouped the same way no
+// matter which container or machine backuparr runs on.
+const host = "backuparr"
+
+// lockWait is how long commands wait for another backuparr job (or a prune)
+// holding the repository lock.
+const lockWait = "10m"
+
+// New creates a new restic storage backend from the given config. The
+// repository must already be initialised with `restic init`.
+func New(cfg Config) (*ResticBackend, error) {
+	if cfg.Repository == "" {
+		return nil, fmt.Errorf("restic: repository is required")
+	}
+	if cfg.Password == "" && cfg.PasswordFile == "" {
+		return nil, fmt.Errorf("restic: password or passwordFile is required")
+	}
+	binary := cfg.Binary
+	if binary == "" {
+		binary = "restic"
+	}
+
+	env := append(os.Environ(), "RESTIC_REPOSITORY="+cfg.Repository)
+	if cfg.PasswordFile != "" {
+		env = append(env, "RESTIC_PASSWORD_FILE="+cfg.PasswordFile)
+	} else {
+		env = append(env, "RESTIC_PASSWORD="+cfg.Password)
+	}
+
+	return &ResticBackend{binary: binary, env: env}, nil
+}
+
+func (b *ResticBackend) Type() string { return "restic" }
+
+func (b *ResticBackend) Name() string {
+	if b.name != "" {
+		return b.name
+	}
+	return b.Type()
+}
+
+func (b *ResticBackend) SetName(name string) { b.name = name }
+
+// command prepares a restic invocation. Cancelling ctx interrupts restic
+// so it removes its lock and exits without creating a snapshot.
+func (b *ResticBackend) command(ctx context.Context, args ...string) (*exec.Cmd, *bytes.Buffer) {
+	args = append([]string{"--retry-lock", lockWait}, args...)
+	cmd := exec.CommandContext(ctx, b.binary, args...)
+	cmd.Env = b.env
+	cmd.Cancel = func() error { return cmd.Process.Signal(os.Interrupt) }
+	cmd.WaitDelay = 30 * time.Second
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	return cmd, &stderr
+}
+
+// run runs restic to completion and returns its standard output.
+func (b *ResticBackend) run(ctx context.Context, args ...string) ([]byte, error) {
+	cmd, stderr := b.command(ctx, args...)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, commandError(args[0], err, stderr)
+	}
+	return out, nil
+}
+
+func commandError(subcommand string, err error, stderr *bytes.Buffer) error {
+	msg := strings.TrimSpace(stderr.String())
+	if msg == "" {
+		return fmt.Errorf("restic %s failed: %w", subcommand, err)
+	}
+	return fmt.Errorf("restic %s failed: %w - %s", subcommand, err, msg)
+}
+
+// snapshot is the subset of `restic snapshots --json` output used here.
+type snapshot struct {
+	ID      string    `json:"id"`
+	ShortID string    `json:"short_id"`
+	Time    time.Time `json:"time"`
+	Paths   []string  `json:"paths"`
+	Tags    []string  `json:"tags"`
+	// Summary is only recorded by restic 0.17 and newer.
+	Summary *struct {
+		TotalBytesProcessed int64 `json:"total_bytes_processed"`
+	} `json:"summary"`
+}
+
+// fileName is the name the snapshot's data was stored under with
+// --stdin-filename.
+func (s *snapshot) fileName() string {
+	if len(s.Paths) == 0 {
+		return ""
+	}
+	return path.Base(s.Paths[0])
+}
+
+func (s *snapshot) hasTag(tag string) bool {
+	for _, t := range s.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// appName returns the app the snapshot was tagged with.
+func (s *snapshot) appName() string {
+	for _, t := range s.Tags {
+		if t != "" {
+			return t
+		}
+	}
+	return ""
+}
+
+func (s *snapshot) metadata() storage.BackupMetadata {
+	meta := storage.BackupMetadata{
+		Key:       s.ID,
+		AppName:   s.appName(),
+		FileName:  s.fileName(),
+		CreatedAt: s.Time,
+		Encrypted: storage.IsEncryptedName(s.fileName()),
+	}
+	if s.Summary != nil {
+		meta.Size = s.Summary.TotalBytesProcessed
+	}
+	return meta
+}
+
+// snapshots looks up snapshots by ID.
+func (b *ResticBackend) snapshots(ctx context.Context, ids ...string) ([]snapshot, error) {
+	out, err := b.run(ctx, append([]string{"snapshots", "--json"}, ids...)...)
+	if err != nil {
+		return nil, err
+	}
+	return parseSnapshots(out)
+}
+
+// appSnapshots lists all snapshots backuparr stored for an app.
+func (b *ResticBackend) appSnapshots(ctx context.Context, appName string) ([]snapshot, error) {
+	out, err := b.run(ctx, "snapshots", "--json", "--host", host, "--tag", appName)
+	if err != nil {
+		return nil, err
+	}
+	return parseSnapshots(out)
+}
+
+func parseSnapshots(data []byte) ([]snapshot, error) {
+	var snaps []snapshot
+	if err := json.Unmarshal(data, &snaps); err != nil {
+		return nil, fmt.Errorf("restic: failed to parse snapshots: %w", err)
+	}
+	return snaps, nil
+}
+
+// backupSummary is the final message of `restic backup --json`.
+type backupSummary struct {
+	MessageType         string `json:"message_type"`
+	SnapshotID          string `json:"snapshot_id"`
+	TotalBytesProcessed int64  `json:"total_bytes_processed"`
+}
+
+// parseBackupSummary finds the summary among the JSON lines restic backup
+// prints.
+func parseBackupSummary(out []byte) (*backupSummary, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(make([]byte, 64<<10), 1<<20)
+	for scanner.Scan() {
+		var msg backupSummary
+		if json.Unmarshal(scanner.Bytes(), &msg) == nil && msg.MessageType == "summary" && msg.SnapshotID != "" {
+			return &msg, nil
+		}
+	}
+	return nil, errors.New("restic: backup printed no snapshot summary")
+}
+
+// sourceReader records a read error so it can be told apart from restic
+// exiting early, which shows up as a write error on its stdin.
+type sourceReader struct {
+	r   io.Reader
+	err error
+}
+
+func (s *sourceReader) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	if err != nil && err != io.EOF {
+		s.err = err
+	}
+	return n, err
+}
+
+// Upload stores data as a new snapshot read from stdin under fileName and
+// tagged with appName. If reading data fails, restic is interrupted before
+// it sees the end of input, so no truncated snapshot is ever created.
+func (b *ResticBackend) Upload(ctx context.Context, appName string, fileName string, data io.Reader, size int64) (*storage.BackupMetadata, error) {
+	cmd, stderr := b.command(ctx, "backup", "--json",
+		"--stdin", "--stdin-filename", fileName,
+		"--host", host, "--tag", appName)
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("restic: failed to create stdin pipe: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("restic: failed to start %s: %w", b.binary, err)
+	}
+
+	src := &sourceReader{r: data}
+	_, copyErr := io.Copy(stdin, src)
+	if src.err != nil {
+		cmd.Process.Signal(os.Interrupt)
+	}
+	stdin.Close()
+	waitErr := cmd.Wait()
+	if src.err != nil {
+		return nil, fmt.Errorf("restic: failed to read backup data: %w", src.err)
+	}
+	if waitErr != nil {
+		return nil, commandError("backup", waitErr, stderr)
+	}
+	if copyErr != nil {
+		return nil, fmt.Errorf("restic: failed to write to restic: %w", copyErr)
+	}
+
+	summary, err := parseBackupSummary(stdout.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.BackupMetadata{
+		Key:       summary.SnapshotID,
+		AppName:   appName,
+		FileName:  fileName,
+		Size:      summary.TotalBytesProcessed,
+		Encrypted: storage.IsEncryptedName(fileName),
+	}, nil
+}
+
+// dumpReader streams `restic dump` output. Reaching EOF waits for restic,
+// so a failed dump surfaces as a read error instead of a truncated backup.
+type dumpReader struct {
+	io.ReadCloser
+	cmd    *exec.Cmd
+	stderr *bytes.Buffer
+	done   bool
+}
+
+func (r *dumpReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if err == io.EOF && !r.done {
+		r.done = true
+		if waitErr := r.cmd.Wait(); waitErr != nil {
+			return n, commandError("dump", waitErr, r.stderr)
+		}
+	}
+	return n, err
+}
+
+func (r *dumpReader) Close() error {
+	if r.done {
+		return nil
+	}
+	r.done = true
+	r.cmd.Process.Signal(os.Interrupt)
+	r.ReadCloser.Close()
+	r.cmd.Wait()
+	return nil
+}
+
+// dump streams the file stored in a snapshot.
+func (b *ResticBackend) dump(ctx context.Context, snap *snapshot) (io.ReadCloser, error) {
+	cmd, stderr := b.command(ctx, "dump", snap.ID, "/"+snap.fileName())
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("restic: failed to create stdout pipe: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("restic: failed to start %s: %w", b.binary, err)
+	}
+	return &dumpReader{ReadCloser: stdout, cmd: cmd, stderr: stderr}, nil
+}
+
+// lookup finds a single snapshot by ID.
+func (b *ResticBackend) lookup(ctx context.Context, id string) (*snapshot, error) {
+	snaps, err := b.snapshots(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("restic: backup not found: %w", err)
+	}
+	if len(snaps) != 1 {
+		return nil, fmt.Errorf("restic: backup not found: %s: %w", id, storage.ErrNotFound)
+	}
+	return &snaps[0], nil
+}
+
+// manifestSnapshot finds the manifest snapshot stored next to a backup
+// snapshot, or nil if the backup has none.
+func (b *ResticBackend) manifestSnapshot(ctx context.Context, target *snapshot) (*snapshot, error) {
+	app := target.appName()
+	if app == "" {
+		return nil, nil
+	}
+	siblings, err := b.appSnapshots(ctx, app)
+	if err != nil {
+		return nil, fmt.Errorf("restic: failed to list snapshots: %w", err)
+	}
+	_, manifests := splitSnapshots(siblings, app)
+	return manifests[target.fileName()+storage.ManifestSuffix], nil
+}
+
+// Download streams a backup by its snapshot ID, or the manifest of that
+// backup for its storage.ManifestKey. Caller must close the reader.
+func (b *ResticBackend) Download(ctx context.Context, key string) (io.ReadCloser, *storage.BackupMetadata, error) {
+	snap, err := b.lookup(ctx, strings.TrimSuffix(key, storage.ManifestSuffix))
+	if err != nil {
+		return nil, nil, err
+	}
+	if storage.IsManifestName(key) {
+		if snap, err = b.manifestSnapshot(ctx, snap); err != nil {
+			return nil, nil, err
+		}
+		if snap == nil {
+			return nil, nil, fmt.Errorf("restic: manifest not found: %s: %w", key, storage.ErrNotFound)
+		}
+	}
+
+	rc, err := b.dump(ctx, snap)
+	if err != nil {
+		return nil, nil, err
+	}
+	meta := snap.metadata()
+	return rc, &meta, nil
+}
+
+// List returns all backups for an app, sorted newest-first by creation time
+// (from the manifest, falling back to the snapshot time).
+func (b *ResticBackend) List(ctx context.Context, appName string) ([]storage.BackupMetadata, error) {
+	snaps, err := b.appSnapshots(ctx, appName)
+	if err != nil {
+		return nil, fmt.Errorf("restic: failed to list snapshots: %w", err)
+	}
+
+	backups, manifests := splitSnapshots(snaps, appName)
+
+	// Manifests are authoritative: the snapshot time is when the upload
+	// finished, not when the backup was taken. Only snapshots already in
+	// the listing are dumped, so List costs one snapshots call plus one
+	// dump per manifest.
+	listed := make(map[string]bool, len(manifests))
+	for name := range manifests {
+		listed[name] = true
+	}
+	storage.ApplyManifests(backups, listed, func(meta *storage.BackupMetadata) (io.ReadCloser, error) {
+		return b.dump(ctx, manifests[meta.FileName+storage.ManifestSuffix])
+	})
+
+	// Sort newest-first
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.After(backups[j].CreatedAt)
+	})
+
+	return backups, nil
+}
+
+// splitSnapshots separates an app's backup snapshots from its manifest
+// snapshots, which are keyed by file name. When a file name was stored more
+// than once the newest snapshot wins.
+func splitSnapshots(snaps []snapshot, appName string) ([]storage.BackupMetadata, map[string]*snapshot) {
+	var backups []storage.BackupMetadata
+	manifests := make(map[string]*snapshot)
+	for i := range snaps {
+		snap := &snaps[i]
+		name := snap.fileName()
+		if !snap.hasTag(appName) {
+			continue
+		}
+		if storage.IsManifestName(name) {
+			if prev, ok := manifests[name]; !ok || snap.Time.After(prev.Time) {
+				manifests[name] = snap
+			}
+			continue
+		}
+		if !storage.IsBackupFile(name) {
+			continue
+		}
+		meta := snap.metadata()
+		meta.AppName = appName
+		backups = append(backups, meta)
+	}
+	return backups, manifests
+}
+
+// Delete forgets a backup's snapshot together with its manifest snapshot.
+// Deleting a manifest key on its own is a no-op for that reason. The data
+// stays in the repository until the next Prune.
+func (b *ResticBackend) Delete(ctx context.Context, key string) error {
+	if storage.IsManifestName(key) {
+		return nil
+	}
+
+	target, err := b.lookup(ctx, key)
+	if err != nil {
+		return err
+	}
+	ids := []string{target.ID}
+	manifest, err := b.manifestSnapshot(ctx, target)
+	if err != nil {
+		return err
+	}
+	if manifest != nil {
+		ids = append(ids, manifest.ID)
+	}
+
+	if _, err := b.run(ctx, append([]string{"forget"}, ids...)...); err != nil {
+		return fmt.Errorf("restic: failed to forget %s: %w", key, err)
+	}
+	return nil
+}
+
+// Prune removes data no longer referenced by any snapshot.
+func (b *ResticBackend) Prune(ctx context.Context) error {
+	if _, err := b.run(ctx, "prune"); err != nil {
+		return fmt.Errorf("restic: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package restic
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"backuparr/internal/storage"
+)
+
+func TestNew_Validation(t *testing.T) {
+	if _, err := New(Config{Repository: "/srv/restic", Password: "secret"}); err != nil {
+		t.Fatalf("valid config: %v", err)
+	}
+	if _, err := New(Config{Password: "secret"}); err == nil {
+		t.Error("expected error without repository")
+	}
+	if _, err := New(Config{Repository: "/srv/restic"}); err == nil {
+		t.Error("expected error without password")
+	}
+}
+
+func TestParseBackupSummary(t *testing.T) {
+	out := `{"message_type":"status","percent_done":0.5}
+{"message_type":"summary","files_new":1,"total_bytes_processed":1234,"snapshot_id":"5b0a1c2d3e4f"}
+`
+	summary, err := parseBackupSummary([]byte(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.SnapshotID != "5b0a1c2d3e4f" || summary.TotalBytesProcessed != 1234 {
+		t.Errorf("summary = %+v", summary)
+	}
+	if _, err := parseBackupSummary([]byte(`{"message_type":"status"}`)); err == nil {
+		t.Error("expected error without summary")
+	}
+}
+
+func TestSplitSnapshots(t *testing.T) {
+	snaps, err := parseSnapshots([]byte(`[
+		{"id":"aaa","time":"2026-02-05T12:00:01Z","paths":["/sonarr_2026-02-05T120000Z.zip"],"tags":["sonarr"],
+		 "summary":{"total_bytes_processed":500}},
+		{"id":"bbb","time":"2026-02-05T12:00:02Z","paths":["/sonarr_2026-02-05T120000Z.zip.manifest.json"],"tags":["sonarr"]},
+		{"id":"ccc","time":"2026-02-06T12:00:01Z","paths":["/sonarr_2026-02-06T120000Z.zip"],"tags":["sonarr"]},
+		{"id":"ddd","time":"2026-02-06T12:00:01Z","paths":["/home/user"],"tags":["sonarr"]},
+		{"id":"eee","time":"2026-02-06T12:00:01Z","paths":["/radarr_2026-02-06T120000Z.zip"],"tags":["radarr"]}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backups, manifests := splitSnapshots(snaps, "sonarr")
+	if len(backups) != 2 || backups[0].Key != "aaa" || backups[0].Size != 500 || backups[1].Key != "ccc" {
+		t.Errorf("backups = %+v", backups)
+	}
+	if backups[0].AppName != "sonarr" || backups[0].FileName != "sonarr_2026-02-05T120000Z.zip" {
+		t.Errorf("backups[0] = %+v", backups[0])
+	}
+	if m := manifests["sonarr_2026-02-05T120000Z.zip.manifest.json"]; m == nil || m.ID != "bbb" {
+		t.Errorf("manifests = %v", manifests)
+	}
+
+	if snaps, err := parseSnapshots([]byte("null")); err != nil || len(snaps) != 0 {
+		t.Errorf("empty repository = %v, %v", snaps, err)
+	}
+}
+
+// newTestRepo initialises a local restic repository, skipping the test when
+// restic is not installed.
+func newTestRepo(t *testing.T) *ResticBackend {
+	t.Helper()
+	if _, err := exec.LookPath("restic"); err != nil {
+		t.Skip("restic not found on PATH, skipping restic integration tests")
+	}
+	b, err := New(Config{Repository: filepath.Join(t.TempDir(), "repo"), Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("restic", "init")
+	cmd.Env = b.env
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("restic init: %v\n%s", err, out)
+	}
+	return b
+}
+
+func TestRoundTrip(t *testing.T) {
+	b := newTestRepo(t)
+	ctx := context.Background()
+
+	older := storage.FormatBackupName("sonarr", time.Date(2026, 2, 5, 12, 0, 0, 0, time.UTC))
+	newer := storage.FormatBackupName("sonarr", time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC))
+	meta, err := b.Upload(ctx, "sonarr", older, strings.NewReader("old backup"), -1)
+	if err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if meta.Key == "" || meta.Size != 10 {
+		t.Errorf("meta = %+v", meta)
+	}
+	m := storage.Manifest{CreatedAt: time.Date(2026, 2, 5, 12, 0, 0, 0, time.UTC)}
+	if err := storage.WriteManifest(ctx, b, meta, m); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Upload(ctx, "sonarr", newer, strings.NewReader("new backup"), -1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Upload(ctx, "radarr", "radarr_2026-02-06T120000Z.zip", strings.NewReader("radarr"), -1); err != nil {
+		t.Fatal(err)
+	}
+
+	backups, err := b.List(ctx, "sonarr")
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(backups) != 2 || backups[0].FileName != newer {
+		t.Fatalf("List = %+v", backups)
+	}
+	if !backups[1].CreatedAt.Equal(m.CreatedAt) {
+		t.Errorf("manifest not applied: %+v", backups[1])
+	}
+
+	rc, dl, err := b.Download(ctx, meta.Key)
+	if err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	data, err := io.ReadAll(rc)
+	rc.Close()
+	if err != nil || string(data) != "old backup" || dl.AppName != "sonarr" || dl.FileName != older {
+		t.Errorf("Download = %q, %+v, %v", data, dl, err)
+	}
+
+	if got, err := storage.ReadManifest(ctx, b, meta.Key); err != nil || !got.CreatedAt.Equal(m.CreatedAt) {
+		t.Errorf("ReadManifest = %+v, %v", got, err)
+	}
+
+	// Retention forgets the older backup with its manifest and prunes.
+	deleted, err := storage.ApplyRetention(ctx, b, "sonarr", storage.RetentionPolicy{KeepLast: 1})
+	if err != nil || deleted != 1 {
+		t.Fatalf("ApplyRetention = %d, %v", deleted, err)
+	}
+	snaps, err := b.appSnapshots(ctx, "sonarr")
+	if err != nil || len(snaps) != 1 || snaps[0].fileName() != newer {
+		t.Errorf("snapshots after retention = %+v, %v", snaps, err)
+	}
+	if _, _, err := b.Download(ctx, meta.Key); err == nil {
+		t.Error("Download of forgotten snapshot: expected error")
+	}
+}
+
+func TestUpload_ReadError(t *testing.T) {
+	b := newTestRepo(t)
+	ctx := context.Background()
+
+	r := io.MultiReader(strings.NewReader("partial"), &failingReader{})
+	if _, err := b.Upload(ctx, "sonarr", "sonarr_2026-02-06T120000Z.zip", r, -1); err == nil {
+		t.Fatal("expected error")
+	}
+	if snaps, err := b.appSnapshots(ctx, "sonarr"); err != nil || len(snaps) != 0 {
+		t.Errorf("truncated snapshot created: %+v, %v", snaps, err)
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, io.ErrUnexpectedEOF }
@@ -17,7 +17,15 @@ type RetentionPolicy struct {
 	KeepYearly  int
 }
 
+// Pruner is implemented by backends where Delete only drops a reference to
+// the data and the space is reclaimed separately, like restic's forget and
+// prune.
+type Pruner interface {
+	Prune(ctx context.Context) error
+}
+
 // ApplyRetention lists existing backups and deletes those that exceed the policy.
+// Backends implementing Pruner are pruned once afterwards.
 // Returns the number of backups deleted.
 func ApplyRetention(ctx context.Context, backend Backend, appName string, policy RetentionPolicy) (int, error) {
 	backups, err := backend.List(ctx, appName)
@@ -34,7 +42,7 @@ func ApplyRetention(ctx context.Context, backend Backend, appName string, policy
 	deleted := 0
 	for _, b := range backups {
 		if _, keep := toKeep[b.Key]; !keep {
-			if err := backend.Delete(ctx, b.Key); err != nil {
+			if err := DeleteBackup(ctx, backend, b.Key); err != nil {
 				log.Printf("[%s] Failed to delete old backup %s: %v", appName, b.FileName, err)
 				continue
 			}
@@ -42,6 +50,12 @@ func ApplyRetention(ctx context.Context, backend Backend, appName string, policy
 		}
 	}
 
+	if p, ok := backend.(Pruner); ok && deleted > 0 {
+		if err := p.Prune(ctx); err != nil {
+			log.Printf("[%s] Failed to prune %s: %v", appName, backend.Name(), err)
+		}
+	}
+
 	return deleted, nil
 }
 
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"testing"
 	"time"
 )
@@ -225,3 +226,51 @@ func TestSelectBackupsToKeep_KeepLastExceedsTotal(t *testing.T) {
 		t.Errorf("expected 2 kept, got %d", len(keep))
 	}
 }
+
+// pruningBackend lists fixed backups and records deletes and prunes.
+type pruningBackend struct {
+	memBackend
+	backups []BackupMetadata
+	deleted []string
+	pruned  int
+}
+
+func (p *pruningBackend) List(ctx context.Context, appName string) ([]BackupMetadata, error) {
+	return p.backups, nil
+}
+
+func (p *pruningBackend) Delete(ctx context.Context, key string) error {
+	p.deleted = append(p.deleted, key)
+	return nil
+}
+
+func (p *pruningBackend) Prune(ctx context.Context) error {
+	p.pruned++
+	return nil
+}
+
+func TestApplyRetention_Prunes(t *testing.T) {
+	now := time.Date(2026, 6, 15, 12, 0, 0, 0, time.UTC)
+	b := &pruningBackend{backups: []BackupMetadata{
+		makeBackup("b1", now),
+		makeBackup("b2", now.Add(-time.Hour)),
+		makeBackup("b3", now.Add(-2*time.Hour)),
+	}}
+
+	deleted, err := ApplyRetention(context.Background(), b, "test", RetentionPolicy{KeepLast: 1})
+	if err != nil || deleted != 2 {
+		t.Fatalf("ApplyRetention = %d, %v", deleted, err)
+	}
+	if b.pruned != 1 {
+		t.Errorf("pruned %d times, want 1", b.pruned)
+	}
+
+	// Nothing to delete, nothing to prune.
+	b.backups = b.backups[:1]
+	if _, err := ApplyRetention(context.Background(), b, "test", RetentionPolicy{KeepLast: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if b.pruned != 1 {
+		t.Errorf("pruned %d times, want 1", b.pruned)
+	}
+}
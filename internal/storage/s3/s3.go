@@ -1,7 +1,9 @@
 package s3
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"path"
@@ -118,31 +120,133 @@ func (b *S3Backend) objectKey(appName, fileName string) string {
 	return path.Join(b.prefix, appName, fileName)
 }
 
-// Upload stores backup data as an S3 object.
+// partSize is the size of each multipart upload part. Upload buffers at most
+// one part in memory, so memory use is bounded regardless of backup size.
+// With S3's 10,000 part limit this allows backups up to ~156 GiB.
+const partSize = 16 << 20
+
+// Upload stores backup data as an S3 object. Small backups are sent with a
+// single PutObject; anything larger than one part is streamed as a
+// multipart upload so the full backup never has to be held in memory.
 func (b *S3Backend) Upload(ctx context.Context, appName string, fileName string, data io.Reader, size int64) (*storage.BackupMetadata, error) {
 	key := b.objectKey(appName, fileName)
 
-	input := &s3.PutObjectInput{
+	buf := make([]byte, partSize)
+	n, err := io.ReadFull(data, buf)
+	switch {
+	case err == io.EOF || err == io.ErrUnexpectedEOF:
+		// Fits in a single part
+		if err := b.putObject(ctx, key, buf[:n]); err != nil {
+			return nil, err
+		}
+		return &storage.BackupMetadata{
+			Key:       key,
+			AppName:   appName,
+			FileName:  fileName,
+			Size:      int64(n),
+			Encrypted: storage.IsEncryptedName(fileName),
+		}, nil
+	case err != nil:
+		return nil, fmt.Errorf("s3: failed to read backup data: %w", err)
+	}
+
+	written, err := b.multipartUpload(ctx, key, buf, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.BackupMetadata{
+		Key:       key,
+		AppName:   appName,
+		FileName:  fileName,
+		Size:      written,
+		Encrypted: storage.IsEncryptedName(fileName),
+	}, nil
+}
+
+func (b *S3Backend) putObject(ctx context.Context, key string, data []byte) error {
+	_, err := b.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(b.bucket),
+		Key:           aws.String(key),
+		Body:          bytes.NewReader(data),
+		ContentLength: aws.Int64(int64(len(data))),
+		StorageClass:  b.storageClass,
+	})
+	if err != nil {
+		return fmt.Errorf("s3: failed to upload %s: %w", key, err)
+	}
+	return nil
+}
+
+// multipartUpload uploads first (a full part already read from data)
+// followed by the remainder of data, reusing first as the part buffer.
+// The upload is aborted on any error so no orphaned parts are left behind.
+func (b *S3Backend) multipartUpload(ctx context.Context, key string, first []byte, data io.Reader) (int64, error) {
+	created, err := b.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
 		Bucket:       aws.String(b.bucket),
 		Key:          aws.String(key),
-		Body:         data,
 		StorageClass: b.storageClass,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("s3: failed to start multipart upload %s: %w", key, err)
 	}
-	if size > 0 {
-		input.ContentLength = aws.Int64(size)
+	uploadID := created.UploadId
+
+	abort := func(cause error) (int64, error) {
+		// Use a fresh context so the abort still happens if ctx was cancelled.
+		_, abortErr := b.client.AbortMultipartUpload(context.Background(), &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(b.bucket),
+			Key:      aws.String(key),
+			UploadId: uploadID,
+		})
+		if abortErr != nil {
+			return 0, fmt.Errorf("%w (abort also failed: %v)", cause, abortErr)
+		}
+		return 0, cause
 	}
 
-	_, err := b.client.PutObject(ctx, input)
+	var parts []s3types.CompletedPart
+	var written int64
+	buf := first
+	n := len(first)
+	for partNum := int32(1); ; partNum++ {
+		out, err := b.client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:        aws.String(b.bucket),
+			Key:           aws.String(key),
+			UploadId:      uploadID,
+			PartNumber:    aws.Int32(partNum),
+			Body:          bytes.NewReader(buf[:n]),
+			ContentLength: aws.Int64(int64(n)),
+		})
+		if err != nil {
+			return abort(fmt.Errorf("s3: failed to upload part %d of %s: %w", partNum, key, err))
+		}
+		parts = append(parts, s3types.CompletedPart{
+			ETag:       out.ETag,
+			PartNumber: aws.Int32(partNum),
+		})
+		written += int64(n)
+
+		n, err = io.ReadFull(data, buf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return abort(fmt.Errorf("s3: failed to read backup data: %w", err))
+		}
+	}
+
+	_, err = b.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(b.bucket),
+		Key:             aws.String(key),
+		UploadId:        uploadID,
+		MultipartUpload: &s3types.CompletedMultipartUpload{Parts: parts},
+	})
 	if err != nil {
-		return nil, fmt.Errorf("s3: failed to upload %s: %w", key, err)
+		return abort(fmt.Errorf("s3: failed to complete upload %s: %w", key, err))
 	}
 
-	return &storage.BackupMetadata{
-		Key:      key,
-		AppName:  appName,
-		FileName: fileName,
-		Size:     size,
-	}, nil
+	return written, nil
 }
 
 // Download retrieves a backup object from S3. Caller must close the reader.
@@ -152,6 +256,10 @@ func (b *S3Backend) Download(ctx context.Context, key string) (io.ReadCloser, *s
 		Key:    aws.String(key),
 	})
 	if err != nil {
+		var noSuchKey *s3types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, nil, fmt.Errorf("s3: backup not found: %s: %w", key, storage.ErrNotFound)
+		}
 		return nil, nil, fmt.Errorf("s3: failed to download %s: %w", key, err)
 	}
 
@@ -165,10 +273,11 @@ func (b *S3Backend) Download(ctx context.Context, key string) (io.ReadCloser, *s
 	}
 
 	meta := &storage.BackupMetadata{
-		Key:      key,
-		AppName:  appName,
-		FileName: fileName,
-		Size:     size,
+		Key:       key,
+		AppName:   appName,
+		FileName:  fileName,
+		Size:      size,
+		Encrypted: storage.IsEncryptedName(fileName),
 	}
 	if output.LastModified != nil {
 		meta.CreatedAt = *output.LastModified
@@ -182,6 +291,7 @@ func (b *S3Backend) List(ctx context.Context, appName string) ([]storage.BackupM
 	prefix := path.Join(b.prefix, appName) + "/"
 
 	var backups []storage.BackupMetadata
+	manifests := make(map[string]bool)
 	paginator := s3.NewListObjectsV2Paginator(b.client, &s3.ListObjectsV2Input{
 		Bucket: aws.String(b.bucket),
 		Prefix: aws.String(prefix),
@@ -197,14 +307,19 @@ func (b *S3Backend) List(ctx context.Context, appName string) ([]storage.BackupM
 				continue
 			}
 			_, fileName := parseKey(b.prefix, *obj.Key)
-			// Only include .zip files
-			if !strings.HasSuffix(fileName, ".zip") {
+			if storage.IsManifestName(fileName) {
+				manifests[fileName] = true
+				continue
+			}
+			// Only include backup files (.zip, or .zip.age when encrypted)
+			if !storage.IsBackupFile(fileName) {
 				continue
 			}
 			meta := storage.BackupMetadata{
-				Key:      *obj.Key,
-				AppName:  appName,
-				FileName: fileName,
+				Key:       *obj.Key,
+				AppName:   appName,
+				FileName:  fileName,
+				Encrypted: storage.IsEncryptedName(fileName),
 			}
 			if obj.Size != nil {
 				meta.Size = *obj.Size
@@ -216,6 +331,19 @@ func (b *S3Backend) List(ctx context.Context, appName string) ([]storage.BackupM
 		}
 	}
 
+	// Manifests are authoritative over LastModified, which changes when
+	// objects are copied between buckets.
+	storage.ApplyManifests(backups, manifests, func(meta *storage.BackupMetadata) (io.ReadCloser, error) {
+		output, err := b.client.GetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(b.bucket),
+			Key:    aws.String(storage.ManifestKey(meta.Key)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.Body, nil
+	})
+
 	// Sort newest-first
 	sort.Slice(backups, func(i, j int) bool {
 		return backups[i].CreatedAt.After(backups[j].CreatedAt)
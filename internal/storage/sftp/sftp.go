@@ -0,0 +1,361 @@
+// Package sftp implements a storage backend on any SSH server with the SFTP
+// subsystem (a Hetzner Storage Box, a NAS, a VPS). Backups use the same
+// <path>/<appName>/<fileName> layout as the local backend.
+package sftp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"backuparr/internal/storage"
+)
+
+// Ensure SFTPBackend implements storage.Backend at compile time.
+var _ storage.Backend = (*SFTPBackend)(nil)
+
+// Config holds the configuration for an SFTP storage backend.
+type Config struct {
+	Host string
+	Port int // defaults to 22
+	User string
+	Path string // base directory on the server, defaults to "backuparr"
+
+	// Password and/or a private key authenticate the user.
+	Password             string
+	PrivateKeyFile       string
+	PrivateKeyPassphrase string
+
+	// The server's host key is verified against KnownHostsFile (OpenSSH
+	// known_hosts format, e.g. from ssh-keyscan) or HostKey (a single
+	// authorized_keys-format line). One of them is required.
+	KnownHostsFile string
+	HostKey        string
+}
+
+// SFTPBackend stores backups on an SFTP server. Each operation opens its
+// own SSH connection, so idle timeouts on the server never break a
+// long-running daemon.
+type SFTPBackend struct {
+	addr     string
+	basePath string
+	config   *ssh.ClientConfig
+	name     string
+}
+
+// New creates a new SFTP storage backend from the given config. It does not
+// connect; connection problems surface on the first operation.
+func New(cfg Config) (*SFTPBackend, error) {
+	if cfg.Host == "" {
+		return nil, fmt.Errorf("sftp: host is required")
+	}
+	if cfg.User == "" {
+		return nil, fmt.Errorf("sftp: user is required")
+	}
+	port := cfg.Port
+	if port == 0 {
+		port = 22
+	}
+	basePath := strings.TrimSuffix(cfg.Path, "/")
+	if basePath == "" {
+		basePath = "backuparr"
+	}
+
+	var auth []ssh.AuthMethod
+	if cfg.PrivateKeyFile != "" {
+		signer, err := loadPrivateKey(cfg.PrivateKeyFile, cfg.PrivateKeyPassphrase)
+		if err != nil {
+			return nil, err
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if cfg.Password != "" {
+		auth = append(auth, ssh.Password(cfg.Password))
+	}
+	if len(auth) == 0 {
+		return nil, fmt.Errorf("sftp: password or privateKeyFile is required")
+	}
+
+	hostKeyCallback, err := hostKeyCallback(cfg.KnownHostsFile, cfg.HostKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SFTPBackend{
+		addr:     net.JoinHostPort(cfg.Host, strconv.Itoa(port)),
+		basePath: basePath,
+		config: &ssh.ClientConfig{
+			User:            cfg.User,
+			Auth:            auth,
+			HostKeyCallback: hostKeyCallback,
+			Timeout:         30 * time.Second,
+		},
+	}, nil
+}
+
+func loadPrivateKey(file, passphrase string) (ssh.Signer, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("sftp: failed to read private key: %w", err)
+	}
+	var signer ssh.Signer
+	if passphrase != "" {
+		signer, err = ssh.ParsePrivateKeyWithPassphrase(data, []byte(passphrase))
+	} else {
+		signer, err = ssh.ParsePrivateKey(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("sftp: failed to parse private key %s: %w", file, err)
+	}
+	return signer, nil
+}
+
+// hostKeyCallback verifies the server against a known_hosts file or a
+// pinned key. There is deliberately no way to skip verification.
+func hostKeyCallback(knownHostsFile, hostKey string) (ssh.HostKeyCallback, error) {
+	switch {
+	case knownHostsFile != "":
+		cb, err := knownhosts.New(knownHostsFile)
+		if err != nil {
+			return nil, fmt.Errorf("sftp: failed to load known hosts: %w", err)
+		}
+		return cb, nil
+	case hostKey != "":
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+		if err != nil {
+			return nil, fmt.Errorf("sftp: failed to parse host key: %w", err)
+		}
+		return ssh.FixedHostKey(key), nil
+	default:
+		return nil, fmt.Errorf("sftp: knownHostsFile or hostKey is required to verify the server")
+	}
+}
+
+func (b *SFTPBackend) Type() string { return "sftp" }
+
+func (b *SFTPBackend) Name() string {
+	if b.name != "" {
+		return b.name
+	}
+	return b.Type()
+}
+
+func (b *SFTPBackend) SetName(name string) { b.name = name }
+
+// session is one SSH connection with its SFTP client.
+type session struct {
+	ssh  *ssh.Client
+	sftp *sftp.Client
+}
+
+func (s *session) Close() error {
+	err := s.sftp.Close()
+	if sshErr := s.ssh.Close(); err == nil {
+		err = sshErr
+	}
+	return err
+}
+
+// connect opens an SFTP session. Cancelling ctx while connected closes the
+// connection, which aborts any pending operation.
+func (b *SFTPBackend) connect(ctx context.Context) (*session, func(), error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", b.addr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("sftp: failed to connect to %s: %w", b.addr, err)
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, b.addr, b.config)
+	if err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("sftp: ssh handshake with %s failed: %w", b.addr, err)
+	}
+	sshClient := ssh.NewClient(c, chans, reqs)
+	sftpClient, err := sftp.NewClient(sshClient)
+	if err != nil {
+		sshClient.Close()
+		return nil, nil, fmt.Errorf("sftp: failed to start sftp subsystem: %w", err)
+	}
+	s := &session{ssh: sshClient, sftp: sftpClient}
+	stop := context.AfterFunc(ctx, func() { s.Close() })
+	return s, func() { stop(); s.Close() }, nil
+}
+
+// Upload writes backup data to <path>/<appName>/<fileName>. Data goes to a
+// temporary name first and is renamed into place once complete, so an
+// interrupted upload never shows up as a backup.
+func (b *SFTPBackend) Upload(ctx context.Context, appName string, fileName string, data io.Reader, size int64) (*storage.BackupMetadata, error) {
+	s, done, err := b.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	dir := path.Join(b.basePath, appName)
+	if err := s.sftp.MkdirAll(dir); err != nil {
+		return nil, fmt.Errorf("sftp: failed to create directory %s: %w", dir, err)
+	}
+
+	key := path.Join(dir, fileName)
+	tmp := path.Join(dir, "."+fileName+".partial")
+	f, err := s.sftp.Create(tmp)
+	if err != nil {
+		return nil, fmt.Errorf("sftp: failed to create %s: %w", tmp, err)
+	}
+	written, err := f.ReadFrom(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		s.sftp.Remove(tmp)
+		return nil, fmt.Errorf("sftp: failed to write backup: %w", err)
+	}
+
+	if err := s.rename(tmp, key); err != nil {
+		s.sftp.Remove(tmp)
+		return nil, fmt.Errorf("sftp: failed to move %s into place: %w", key, err)
+	}
+
+	return &storage.BackupMetadata{
+		Key:       key,
+		AppName:   appName,
+		FileName:  fileName,
+		Size:      written,
+		Encrypted: storage.IsEncryptedName(fileName),
+	}, nil
+}
+
+// rename replaces newname with oldname. Plain SFTP rename fails when the
+// target exists, so the posix-rename extension is preferred.
+func (s *session) rename(oldname, newname string) error {
+	if err := s.sftp.PosixRename(oldname, newname); err == nil {
+		return nil
+	}
+	if err := s.sftp.Remove(newname); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return s.sftp.Rename(oldname, newname)
+}
+
+// remoteFile closes its SFTP session together with the file.
+type remoteFile struct {
+	*sftp.File
+	done func()
+}
+
+func (f *remoteFile) Close() error {
+	err := f.File.Close()
+	f.done()
+	return err
+}
+
+// Download opens a backup file by its key (full remote path).
+func (b *SFTPBackend) Download(ctx context.Context, key string) (io.ReadCloser, *storage.BackupMetadata, error) {
+	s, done, err := b.connect(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f, err := s.sftp.Open(key)
+	if err != nil {
+		done()
+		return nil, nil, fmt.Errorf("sftp: backup not found: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		done()
+		return nil, nil, fmt.Errorf("sftp: failed to stat %s: %w", key, err)
+	}
+
+	meta := &storage.BackupMetadata{
+		Key:       key,
+		AppName:   path.Base(path.Dir(key)),
+		FileName:  path.Base(key),
+		Size:      info.Size(),
+		CreatedAt: info.ModTime(),
+		Encrypted: storage.IsEncryptedName(key),
+	}
+	return &remoteFile{File: f, done: done}, meta, nil
+}
+
+// List returns all backup files for an app, sorted newest-first by creation
+// time (from the manifest, falling back to modification time).
+func (b *SFTPBackend) List(ctx context.Context, appName string) ([]storage.BackupMetadata, error) {
+	s, done, err := b.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	dir := path.Join(b.basePath, appName)
+	entries, err := s.sftp.ReadDirContext(ctx, dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("sftp: failed to list directory %s: %w", dir, err)
+	}
+
+	manifests := make(map[string]bool)
+	var backups []storage.BackupMetadata
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if storage.IsManifestName(entry.Name()) {
+			manifests[entry.Name()] = true
+			continue
+		}
+		if !storage.IsBackupFile(entry.Name()) {
+			continue
+		}
+		backups = append(backups, storage.BackupMetadata{
+			Key:       path.Join(dir, entry.Name()),
+			AppName:   appName,
+			FileName:  entry.Name(),
+			Size:      entry.Size(),
+			CreatedAt: entry.ModTime(),
+			Encrypted: storage.IsEncryptedName(entry.Name()),
+		})
+	}
+
+	// Manifests are authoritative: file mtimes change when backups are copied.
+	// Only manifests in the listing are read, all over this one session.
+	storage.ApplyManifests(backups, manifests, func(meta *storage.BackupMetadata) (io.ReadCloser, error) {
+		return s.sftp.Open(storage.ManifestKey(meta.Key))
+	})
+
+	// Sort newest-first
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.After(backups[j].CreatedAt)
+	})
+
+	return backups, nil
+}
+
+// Delete removes a backup file by its key (full remote path).
+func (b *SFTPBackend) Delete(ctx context.Context, key string) error {
+	s, done, err := b.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	if err := s.sftp.Remove(key); err != nil {
+		return fmt.Errorf("sftp: failed to delete %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package sftp
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"backuparr/internal/storage"
+)
+
+// testServer is an in-process SSH server with the SFTP subsystem serving
+// the local filesystem.
+type testServer struct {
+	host, port string
+	hostKey    ssh.PublicKey
+	clientKey  string // path of the authorized client's private key
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	_, hostPriv, _ := ed25519.GenerateKey(rand.Reader)
+	hostSigner, err := ssh.NewSignerFromKey(hostPriv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientPub, clientPriv, _ := ed25519.GenerateKey(rand.Reader)
+	authorized, _ := ssh.NewPublicKey(clientPub)
+	block, err := ssh.MarshalPrivateKey(clientPriv, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(t.TempDir(), "id_ed25519")
+	os.WriteFile(keyFile, pem.EncodeToMemory(block), 0o600)
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
+			if c.User() == "backup" && string(pass) == "secret" {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("password rejected")
+		},
+		PublicKeyCallback: func(c ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if c.User() == "backup" && bytes.Equal(key.Marshal(), authorized.Marshal()) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("key rejected")
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveConn(conn, config)
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+	return &testServer{host: host, port: port, hostKey: hostSigner.PublicKey(), clientKey: keyFile}
+}
+
+func serveConn(conn net.Conn, config *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		conn.Close()
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	for newChan := range chans {
+		if newChan.ChannelType() != "session" {
+			newChan.Reject(ssh.UnknownChannelType, "unsupported")
+			continue
+		}
+		ch, requests, err := newChan.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			for req := range requests {
+				ok := req.Type == "subsystem" && string(req.Payload[4:]) == "sftp"
+				req.Reply(ok, nil)
+				if ok {
+					server, err := sftp.NewServer(ch)
+					if err == nil {
+						server.Serve()
+					}
+					ch.Close()
+				}
+			}
+		}()
+	}
+}
+
+func (s *testServer) config(t *testing.T) Config {
+	port, _ := strconv.Atoi(s.port)
+	return Config{
+		Host:     s.host,
+		Port:     port,
+		User:     "backup",
+		Password: "secret",
+		Path:     t.TempDir(),
+		HostKey:  string(ssh.MarshalAuthorizedKey(s.hostKey)),
+	}
+}
+
+func TestNew_Validation(t *testing.T) {
+	valid := Config{Host: "box", User: "u", Password: "p", HostKey: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"}
+	if _, err := New(valid); err != nil {
+		t.Fatalf("valid config: %v", err)
+	}
+
+	for name, mutate := range map[string]func(*Config){
+		"no host":     func(c *Config) { c.Host = "" },
+		"no user":     func(c *Config) { c.User = "" },
+		"no auth":     func(c *Config) { c.Password = "" },
+		"no host key": func(c *Config) { c.HostKey = "" },
+		"bad key":     func(c *Config) { c.PrivateKeyFile = "/nonexistent" },
+	} {
+		cfg := valid
+		mutate(&cfg)
+		if _, err := New(cfg); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	srv := newTestServer(t)
+	cfg := srv.config(t)
+	b, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	older := storage.FormatBackupName("sonarr", time.Date(2026, 2, 5, 12, 0, 0, 0, time.UTC))
+	newer := storage.FormatBackupName("sonarr", time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC))
+	meta, err := b.Upload(ctx, "sonarr", older, strings.NewReader("old backup"), -1)
+	if err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if meta.Key != filepath.Join(cfg.Path, "sonarr", older) || meta.Size != 10 {
+		t.Errorf("meta = %+v", meta)
+	}
+	if _, err := b.Upload(ctx, "sonarr", newer, strings.NewReader("new backup"), -1); err != nil {
+		t.Fatal(err)
+	}
+	// The manifest's creation time wins over the file mtime.
+	m := storage.Manifest{CreatedAt: time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC)}
+	if err := storage.WriteManifest(ctx, b, meta, m); err != nil {
+		t.Fatal(err)
+	}
+	// Overwriting an existing file replaces it.
+	if _, err := b.Upload(ctx, "sonarr", newer, strings.NewReader("newest backup"), -1); err != nil {
+		t.Fatalf("overwrite: %v", err)
+	}
+
+	backups, err := b.List(ctx, "sonarr")
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(backups) != 2 || backups[0].FileName != newer || backups[0].Size != 13 {
+		t.Fatalf("List = %+v", backups)
+	}
+	if !backups[1].CreatedAt.Equal(m.CreatedAt) {
+		t.Errorf("manifest not applied: %+v", backups[1])
+	}
+
+	rc, dl, err := b.Download(ctx, backups[0].Key)
+	if err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	data, _ := io.ReadAll(rc)
+	rc.Close()
+	if string(data) != "newest backup" || dl.AppName != "sonarr" || dl.FileName != newer {
+		t.Errorf("Download = %q, %+v", data, dl)
+	}
+
+	if err := storage.DeleteBackup(ctx, b, meta.Key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	backups, _ = b.List(ctx, "sonarr")
+	if len(backups) != 1 {
+		t.Errorf("after delete: %+v", backups)
+	}
+	if _, err := os.Stat(storage.ManifestKey(meta.Key)); !os.IsNotExist(err) {
+		t.Error("manifest not deleted")
+	}
+
+	if backups, err := b.List(ctx, "radarr"); err != nil || len(backups) != 0 {
+		t.Errorf("List of missing app = %v, %v", backups, err)
+	}
+}
+
+func TestPrivateKeyAndKnownHosts(t *testing.T) {
+	srv := newTestServer(t)
+	cfg := srv.config(t)
+	cfg.Password = ""
+	cfg.HostKey = ""
+	cfg.PrivateKeyFile = srv.clientKey
+	cfg.KnownHostsFile = filepath.Join(t.TempDir(), "known_hosts")
+	line := knownhosts.Line([]string{knownhosts.Normalize(net.JoinHostPort(srv.host, srv.port))}, srv.hostKey)
+	os.WriteFile(cfg.KnownHostsFile, []byte(line+"\n"), 0o644)
+
+	b, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Upload(context.Background(), "radarr", "radarr_2026-02-06T120000Z.zip", strings.NewReader("x"), 1); err != nil {
+		t.Fatalf("Upload with key auth: %v", err)
+	}
+}
+
+func TestHostKeyMismatch(t *testing.T) {
+	srv := newTestServer(t)
+	other := newTestServer(t)
+	cfg := srv.config(t)
+	cfg.HostKey = string(ssh.MarshalAuthorizedKey(other.hostKey))
+
+	b, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.List(context.Background(), "sonarr"); err == nil || !strings.Contains(err.Error(), "handshake") {
+		t.Errorf("expected handshake failure, got %v", err)
+	}
+}
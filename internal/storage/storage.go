@@ -3,9 +3,15 @@ package storage
 import (
 	"context"
 	"io"
+	"io/fs"
+	"strings"
 	"time"
 )
 
+// ErrNotFound is wrapped by the error Download returns for a key that does
+// not exist. It is fs.ErrNotExist, so filesystem errors satisfy it as is.
+var ErrNotFound = fs.ErrNotExist
+
 // BackupMetadata describes a single backup file stored in a backend.
 type BackupMetadata struct {
 	// Key is the unique identifier within the backend (path, object key, snapshot ID).
@@ -18,6 +24,11 @@ type BackupMetadata struct {
 	Size int64
 	// CreatedAt is when the backup was created.
 	CreatedAt time.Time
+	// Encrypted is true if the stored object is encrypted client-side.
+	Encrypted bool
+	// Checksum is the hex SHA-256 of the backup archive, taken from its
+	// manifest. Empty for backups without a manifest.
+	Checksum string
 }
 
 // Backend is the interface every storage provider implements.
@@ -32,7 +43,8 @@ type Backend interface {
 	SetName(name string)
 	// Upload stores backup data and returns metadata for the stored object.
 	Upload(ctx context.Context, appName string, fileName string, data io.Reader, size int64) (*BackupMetadata, error)
-	// Download retrieves a backup by key. Caller must close the reader.
+	// Download retrieves a backup by key. Caller must close the reader. A
+	// missing key yields an error wrapping ErrNotFound.
 	Download(ctx context.Context, key string) (io.ReadCloser, *BackupMetadata, error)
 	// List returns all backups for a given app, ordered newest-first.
 	List(ctx context.Context, appName string) ([]BackupMetadata, error)
@@ -45,3 +57,31 @@ type Backend interface {
 func FormatBackupName(appName string, t time.Time) string {
 	return appName + "_" + t.UTC().Format("2006-01-02T150405Z") + ".zip"
 }
+
+// EncryptedSuffix is appended to the file name of backups that were
+// encrypted client-side before upload (e.g. "sonarr_...zip.age").
+const EncryptedSuffix = ".age"
+
+// IsBackupFile reports whether a stored file name is a backup, either plain
+// or encrypted.
+func IsBackupFile(name string) bool {
+	return strings.HasSuffix(name, ".zip") || strings.HasSuffix(name, ".zip"+EncryptedSuffix)
+}
+
+// IsEncryptedName reports whether a stored file name marks an encrypted backup.
+func IsEncryptedName(name string) bool {
+	return strings.HasSuffix(name, EncryptedSuffix)
+}
+
+// CountingReader counts the bytes read through it, for backends that only
+// learn the size of an upload by sending it.
+type CountingReader struct {
+	R io.Reader // underlying reader
+	N int64     // bytes read so far
+}
+
+func (c *CountingReader) Read(p []byte) (int, error) {
+	n, err := c.R.Read(p)
+	c.N += int64(n)
+	return n, err
+}
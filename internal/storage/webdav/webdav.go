@@ -0,0 +1,360 @@
+// Package webdav implements a storage backend on a WebDAV server such as
+// Nextcloud, ownCloud or Synology WebDAV Server. Backups use the same
+// <path>/<appName>/<fileName> layout as the local backend.
+package webdav
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"backuparr/internal/storage"
+)
+
+// Ensure WebDAVBackend implements storage.Backend at compile time.
+var _ storage.Backend = (*WebDAVBackend)(nil)
+
+// Config holds the configuration for a WebDAV storage backend.
+type Config struct {
+	// URL is the WebDAV root, e.g.
+	// https://cloud.example.com/remote.php/dav/files/<user> for Nextcloud.
+	URL      string
+	Path     string // base directory below URL, defaults to "backuparr"
+	User     string
+	Password string // for Nextcloud, preferably an app password
+}
+
+// WebDAVBackend stores backups on a WebDAV server.
+type WebDAVBackend struct {
+	root     *url.URL
+	basePath string
+	user     string
+	password string
+	client   *http.Client
+	name     string
+}
+
+// New creates a new WebDAV storage backend from the given config. It does
+// not connect; connection problems surface on the first operation.
+func New(cfg Config) (*WebDAVBackend, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("webdav: url is required")
+	}
+	root, err := url.Parse(cfg.URL)
+	if err != nil || (root.Scheme != "http" && root.Scheme != "https") || root.Host == "" {
+		return nil, fmt.Errorf("webdav: invalid url %q", cfg.URL)
+	}
+	root.Path = strings.TrimSuffix(root.Path, "/") + "/"
+	root.RawPath = ""
+
+	basePath := strings.Trim(cfg.Path, "/")
+	if basePath == "" {
+		basePath = "backuparr"
+	}
+
+	return &WebDAVBackend{
+		root:     root,
+		basePath: basePath,
+		user:     cfg.User,
+		password: cfg.Password,
+		client:   &http.Client{},
+	}, nil
+}
+
+func (b *WebDAVBackend) Type() string { return "webdav" }
+
+func (b *WebDAVBackend) Name() string {
+	if b.name != "" {
+		return b.name
+	}
+	return b.Type()
+}
+
+func (b *WebDAVBackend) SetName(name string) { b.name = name }
+
+// resolve returns the URL of a path relative to the WebDAV root.
+func (b *WebDAVBackend) resolve(p string) string {
+	u := *b.root
+	u.Path += strings.TrimPrefix(p, "/")
+	return u.String()
+}
+
+// newRequest builds an authenticated request for a path relative to the
+// WebDAV root.
+func (b *WebDAVBackend) newRequest(ctx context.Context, method, p string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, b.resolve(p), body)
+	if err != nil {
+		return nil, fmt.Errorf("webdav: failed to create request: %w", err)
+	}
+	if b.user != "" || b.password != "" {
+		req.SetBasicAuth(b.user, b.password)
+	}
+	return req, nil
+}
+
+// do sends a request for a path relative to the WebDAV root.
+func (b *WebDAVBackend) do(ctx context.Context, method, p string, body io.Reader, header http.Header) (*http.Response, error) {
+	req, err := b.newRequest(ctx, method, p, body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	return b.client.Do(req)
+}
+
+// statusError drains and closes resp and describes its unexpected status,
+// including the server's message when it is plain text.
+func statusError(resp *http.Response) error {
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	resp.Body.Close()
+	text := strings.TrimSpace(string(msg))
+	if text != "" && !strings.HasPrefix(text, "<") {
+		return fmt.Errorf("server returned %s: %s", resp.Status, text)
+	}
+	return fmt.Errorf("server returned %s", resp.Status)
+}
+
+// mkcol creates the collection dir and its parents. Existing collections
+// answer 405 Method Not Allowed, which is fine.
+func (b *WebDAVBackend) mkcol(ctx context.Context, dir string) error {
+	var current string
+	for _, segment := range strings.Split(dir, "/") {
+		current = path.Join(current, segment)
+		resp, err := b.do(ctx, "MKCOL", current+"/", nil, nil)
+		if err != nil {
+			return err
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated, http.StatusMethodNotAllowed:
+			resp.Body.Close()
+		default:
+			return statusError(resp)
+		}
+	}
+	return nil
+}
+
+// Upload writes backup data to <path>/<appName>/<fileName>. Data goes to a
+// temporary name first and is moved into place once complete, so an
+// interrupted upload never shows up as a backup. Without a known size the
+// body is sent with chunked transfer encoding.
+func (b *WebDAVBackend) Upload(ctx context.Context, appName string, fileName string, data io.Reader, size int64) (*storage.BackupMetadata, error) {
+	dir := path.Join(b.basePath, appName)
+	if err := b.mkcol(ctx, dir); err != nil {
+		return nil, fmt.Errorf("webdav: failed to create directory %s: %w", dir, err)
+	}
+
+	key := path.Join(dir, fileName)
+	tmp := path.Join(dir, "."+fileName+".partial")
+
+	body := &storage.CountingReader{R: data}
+	req, err := b.newRequest(ctx, http.MethodPut, tmp, body)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = -1
+	if size > 0 {
+		req.ContentLength = size
+	}
+	resp, err := b.client.Do(req)
+	if err == nil && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		err = statusError(resp)
+	}
+	if err != nil {
+		b.remove(tmp)
+		return nil, fmt.Errorf("webdav: failed to write backup: %w", err)
+	}
+	resp.Body.Close()
+
+	resp, err = b.do(ctx, "MOVE", tmp, nil, http.Header{
+		"Destination": {b.resolve(key)},
+		"Overwrite":   {"T"},
+	})
+	if err == nil && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		err = statusError(resp)
+	}
+	if err != nil {
+		b.remove(tmp)
+		return nil, fmt.Errorf("webdav: failed to move %s into place: %w", key, err)
+	}
+	resp.Body.Close()
+
+	return &storage.BackupMetadata{
+		Key:       key,
+		AppName:   appName,
+		FileName:  fileName,
+		Size:      body.N,
+		Encrypted: storage.IsEncryptedName(fileName),
+	}, nil
+}
+
+// remove deletes a leftover temporary file, ignoring errors. It does not use
+// the caller's context, which may already be cancelled.
+func (b *WebDAVBackend) remove(p string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if resp, err := b.do(ctx, http.MethodDelete, p, nil, nil); err == nil {
+		resp.Body.Close()
+	}
+}
+
+// Download opens a backup file by its key (path relative to the WebDAV root).
+func (b *WebDAVBackend) Download(ctx context.Context, key string) (io.ReadCloser, *storage.BackupMetadata, error) {
+	resp, err := b.do(ctx, http.MethodGet, key, nil, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("webdav: failed to download %s: %w", key, err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, nil, fmt.Errorf("webdav: backup not found: %s: %w", key, storage.ErrNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("webdav: failed to download %s: %w", key, statusError(resp))
+	}
+
+	meta := &storage.BackupMetadata{
+		Key:       key,
+		AppName:   path.Base(path.Dir(key)),
+		FileName:  path.Base(key),
+		Size:      resp.ContentLength,
+		Encrypted: storage.IsEncryptedName(key),
+	}
+	if t, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		meta.CreatedAt = t
+	}
+	return resp.Body, meta, nil
+}
+
+// propfindBody asks only for the properties List needs.
+const propfindBody = `<?xml version="1.0" encoding="utf-8"?>
+<d:propfind xmlns:d="DAV:"><d:prop><d:resourcetype/><d:getcontentlength/><d:getlastmodified/></d:prop></d:propfind>`
+
+// multistatus is the subset of a PROPFIND response used by List.
+type multistatus struct {
+	Responses []struct {
+		Href     string `xml:"DAV: href"`
+		Propstat []struct {
+			Status string `xml:"DAV: status"`
+			Prop   struct {
+				ResourceType struct {
+					Collection *struct{} `xml:"DAV: collection"`
+				} `xml:"DAV: resourcetype"`
+				ContentLength string `xml:"DAV: getcontentlength"`
+				LastModified  string `xml:"DAV: getlastmodified"`
+			} `xml:"DAV: prop"`
+		} `xml:"DAV: propstat"`
+	} `xml:"DAV: response"`
+}
+
+// List returns all backup files for an app, sorted newest-first by creation
+// time (from the manifest, falling back to modification time).
+func (b *WebDAVBackend) List(ctx context.Context, appName string) ([]storage.BackupMetadata, error) {
+	dir := path.Join(b.basePath, appName)
+	resp, err := b.do(ctx, "PROPFIND", dir+"/", strings.NewReader(propfindBody), http.Header{
+		"Depth":        {"1"},
+		"Content-Type": {"application/xml; charset=utf-8"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("webdav: failed to list directory %s: %w", dir, err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusMultiStatus {
+		return nil, fmt.Errorf("webdav: failed to list directory %s: %w", dir, statusError(resp))
+	}
+	defer resp.Body.Close()
+
+	var ms multistatus
+	if err := xml.NewDecoder(resp.Body).Decode(&ms); err != nil {
+		return nil, fmt.Errorf("webdav: failed to parse PROPFIND response: %w", err)
+	}
+
+	var backups []storage.BackupMetadata
+	manifests := make(map[string]bool)
+	for _, r := range ms.Responses {
+		name, err := hrefName(r.Href)
+		if err != nil {
+			continue
+		}
+		meta := storage.BackupMetadata{
+			Key:       path.Join(dir, name),
+			AppName:   appName,
+			FileName:  name,
+			Encrypted: storage.IsEncryptedName(name),
+		}
+		isDir := false
+		for _, ps := range r.Propstat {
+			if !strings.Contains(ps.Status, " 200 ") {
+				continue
+			}
+			if ps.Prop.ResourceType.Collection != nil {
+				isDir = true
+			}
+			if n, err := strconv.ParseInt(strings.TrimSpace(ps.Prop.ContentLength), 10, 64); err == nil {
+				meta.Size = n
+			}
+			if t, err := http.ParseTime(strings.TrimSpace(ps.Prop.LastModified)); err == nil {
+				meta.CreatedAt = t
+			}
+		}
+		if isDir {
+			continue
+		}
+		if storage.IsManifestName(name) {
+			manifests[name] = true
+			continue
+		}
+		if !storage.IsBackupFile(name) {
+			continue
+		}
+		backups = append(backups, meta)
+	}
+
+	// Manifests override modification times, which change on copy.
+	storage.ApplyManifests(backups, manifests, func(meta *storage.BackupMetadata) (io.ReadCloser, error) {
+		rc, _, err := b.Download(ctx, storage.ManifestKey(meta.Key))
+		return rc, err
+	})
+
+	// Sort newest-first
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.After(backups[j].CreatedAt)
+	})
+
+	return backups, nil
+}
+
+// hrefName returns the last path segment of a PROPFIND href, which servers
+// send either as an absolute path or a full URL, percent-encoded.
+func hrefName(href string) (string, error) {
+	u, err := url.Parse(href)
+	if err != nil {
+		return "", err
+	}
+	return path.Base(strings.TrimSuffix(u.Path, "/")), nil
+}
+
+// Delete removes a backup file by its key (path relative to the WebDAV root).
+func (b *WebDAVBackend) Delete(ctx context.Context, key string) error {
+	resp, err := b.do(ctx, http.MethodDelete, key, nil, nil)
+	if err == nil && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		err = statusError(resp)
+	}
+	if err != nil {
+		return fmt.Errorf("webdav: failed to delete %s: %w", key, err)
+	}
+	resp.Body.Close()
+	return nil
+}
@@ -0,0 +1,196 @@
+package webdav
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	xwebdav "golang.org/x/net/webdav"
+
+	"backuparr/internal/storage"
+)
+
+// newTestServer serves a temporary directory over WebDAV below
+// /remote.php/dav/files/backup, like Nextcloud, with basic auth.
+func newTestServer(t *testing.T) (*httptest.Server, string) {
+	t.Helper()
+	dir := t.TempDir()
+	const prefix = "/remote.php/dav/files/backup"
+	handler := &xwebdav.Handler{
+		Prefix:     prefix,
+		FileSystem: xwebdav.Dir(dir),
+		LockSystem: xwebdav.NewMemLS(),
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "backup" || pass != "secret" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, dir
+}
+
+func TestNew_Validation(t *testing.T) {
+	for _, u := range []string{"", "cloud.example.com", "ftp://cloud.example.com/dav"} {
+		if _, err := New(Config{URL: u}); err == nil {
+			t.Errorf("New(%q): expected error", u)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	srv, dir := newTestServer(t)
+	b, err := New(Config{URL: srv.URL + "/remote.php/dav/files/backup/", Path: "backups/backuparr", User: "backup", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	older := storage.FormatBackupName("sonarr", time.Date(2026, 2, 5, 12, 0, 0, 0, time.UTC))
+	newer := storage.FormatBackupName("sonarr", time.Date(2026, 2, 6, 12, 0, 0, 0, time.UTC))
+	meta, err := b.Upload(ctx, "sonarr", older, strings.NewReader("old backup"), 10)
+	if err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if meta.Key != "backups/backuparr/sonarr/"+older || meta.Size != 10 {
+		t.Errorf("meta = %+v", meta)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "backups", "backuparr", "sonarr", older)); err != nil {
+		t.Fatalf("uploaded file: %v", err)
+	}
+	// Unknown size is sent chunked.
+	if _, err := b.Upload(ctx, "sonarr", newer, io.MultiReader(strings.NewReader("new backup")), 0); err != nil {
+		t.Fatal(err)
+	}
+	m := storage.Manifest{CreatedAt: time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC)}
+	if err := storage.WriteManifest(ctx, b, meta, m); err != nil {
+		t.Fatal(err)
+	}
+	// Overwriting an existing file replaces it.
+	if _, err := b.Upload(ctx, "sonarr", newer, strings.NewReader("newest backup"), 13); err != nil {
+		t.Fatalf("overwrite: %v", err)
+	}
+	entries, _ := os.ReadDir(filepath.Join(dir, "backups", "backuparr", "sonarr"))
+	if len(entries) != 3 {
+		t.Errorf("leftover files: %v", entries)
+	}
+
+	backups, err := b.List(ctx, "sonarr")
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("List = %+v", backups)
+	}
+	if backups[0].FileName != newer || backups[0].Size != 13 || backups[0].CreatedAt.IsZero() {
+		t.Errorf("List[0] = %+v", backups[0])
+	}
+	// The manifest's creation time wins over the file mtime.
+	if !backups[1].CreatedAt.Equal(m.CreatedAt) {
+		t.Errorf("manifest not applied: %+v", backups[1])
+	}
+
+	rc, dl, err := b.Download(ctx, backups[0].Key)
+	if err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	data, _ := io.ReadAll(rc)
+	rc.Close()
+	if string(data) != "newest backup" || dl.AppName != "sonarr" || dl.FileName != newer || dl.Size != 13 {
+		t.Errorf("Download = %q, %+v", data, dl)
+	}
+
+	if err := storage.DeleteBackup(ctx, b, meta.Key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	backups, _ = b.List(ctx, "sonarr")
+	if len(backups) != 1 {
+		t.Errorf("after delete: %+v", backups)
+	}
+	if _, err := os.Stat(filepath.Join(dir, storage.ManifestKey(meta.Key))); !os.IsNotExist(err) {
+		t.Error("manifest not deleted")
+	}
+	if _, _, err := b.Download(ctx, meta.Key); err == nil {
+		t.Error("Download of deleted backup: expected error")
+	}
+
+	if backups, err := b.List(ctx, "radarr"); err != nil || len(backups) != 0 {
+		t.Errorf("List of missing app = %v, %v", backups, err)
+	}
+}
+
+func TestList_ReadsListedManifestsOnly(t *testing.T) {
+	dir := t.TempDir()
+	handler := &xwebdav.Handler{FileSystem: xwebdav.Dir(dir), LockSystem: xwebdav.NewMemLS()}
+	var gets []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			gets = append(gets, path.Base(r.URL.Path))
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	b, err := New(Config{URL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	var metas []*storage.BackupMetadata
+	for day := 5; day <= 7; day++ {
+		name := storage.FormatBackupName("sonarr", time.Date(2026, 2, day, 12, 0, 0, 0, time.UTC))
+		meta, err := b.Upload(ctx, "sonarr", name, strings.NewReader("backup"), 6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		metas = append(metas, meta)
+	}
+	if err := storage.WriteManifest(ctx, b, metas[1], storage.Manifest{CreatedAt: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+
+	backups, err := b.List(ctx, "sonarr")
+	if err != nil || len(backups) != 3 {
+		t.Fatalf("List = %+v, %v", backups, err)
+	}
+	if want := metas[1].FileName + storage.ManifestSuffix; len(gets) != 1 || gets[0] != want {
+		t.Errorf("List fetched %v, want only %s", gets, want)
+	}
+}
+
+func TestEscapedNames(t *testing.T) {
+	srv, _ := newTestServer(t)
+	b, _ := New(Config{URL: srv.URL + "/remote.php/dav/files/backup", Path: "my backups", User: "backup", Password: "secret"})
+	ctx := context.Background()
+
+	name := storage.FormatBackupName("my app", time.Date(2026, 2, 5, 12, 0, 0, 0, time.UTC))
+	if _, err := b.Upload(ctx, "my app", name, strings.NewReader("x"), 1); err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	backups, err := b.List(ctx, "my app")
+	if err != nil || len(backups) != 1 || backups[0].FileName != name {
+		t.Fatalf("List = %+v, %v", backups, err)
+	}
+}
+
+func TestUnauthorized(t *testing.T) {
+	srv, _ := newTestServer(t)
+	b, _ := New(Config{URL: srv.URL + "/remote.php/dav/files/backup", User: "backup", Password: "wrong"})
+
+	if _, err := b.List(context.Background(), "sonarr"); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("List: expected 401, got %v", err)
+	}
+	if _, err := b.Upload(context.Background(), "sonarr", "sonarr_2026-02-06T120000Z.zip", strings.NewReader("x"), 1); err == nil {
+		t.Error("Upload: expected error")
+	}
+}
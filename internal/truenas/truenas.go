@@ -25,6 +25,8 @@
 package truenas
 
 import (
+	"archive/tar"
+	"bufio"
 	"bytes"
 	"context"
 	"crypto/tls"
@@ -136,38 +138,86 @@ func (c *Client) Backup(ctx context.Context) (*backup.BackupResult, io.ReadClose
 // After a successful restore TrueNAS will reboot automatically (with a ~10s
 // delay). The caller should expect the connection to drop shortly after this
 // method returns.
-func (c *Client) Restore(ctx context.Context, backup io.Reader) error {
+//
+// With opts.DryRun the archive is read and its members reported as files
+// that would replace the running configuration; nothing is uploaded.
+func (c *Client) Restore(ctx context.Context, data io.Reader, opts backup.RestoreOptions) (*backup.RestorePlan, error) {
+	plan := &backup.RestorePlan{
+		App:           "truenas",
+		DBType:        backup.DBTypeSQLite,
+		Restart:       true,
+		RestartMethod: "reboot",
+		Notes:         []string{"the entire system configuration of " + c.baseURL + " is replaced"},
+	}
+	if opts.DryRun {
+		files, err := listConfigArchive(data)
+		if err != nil {
+			return nil, err
+		}
+		plan.Files = files
+		return plan, nil
+	}
+
 	// Step 1: Upload the file via multipart POST to /_upload/
-	jobID, err := c.httpUpload(ctx, backup)
+	jobID, err := c.httpUpload(ctx, data)
 	if err != nil {
-		return fmt.Errorf("upload: %w", err)
+		return nil, fmt.Errorf("upload: %w", err)
 	}
 	log.Printf("[truenas] Config upload job %d started, waiting for completion...", jobID)
 
 	// Step 2: Wait for the job to finish via WebSocket
 	ws, err := c.dialWebSocket(ctx)
 	if err != nil {
-		return fmt.Errorf("websocket connect: %w", err)
+		return nil, fmt.Errorf("websocket connect: %w", err)
 	}
 	defer ws.close()
 
 	var authed bool
 	if err := ws.call("auth.login_with_api_key", &authed, c.apiKey); err != nil {
-		return fmt.Errorf("auth: %w", err)
+		return nil, fmt.Errorf("auth: %w", err)
 	}
 	if !authed {
-		return fmt.Errorf("authentication failed: API key was rejected")
+		return nil, fmt.Errorf("authentication failed: API key was rejected")
 	}
 
 	// Poll core.get_jobs until the upload job reaches a terminal state.
 	// We can't rely on core.job_wait because it is itself a job method and
 	// returns immediately via JSON-RPC before the target job finishes.
 	if err := c.waitForJob(ws, jobID); err != nil {
-		return fmt.Errorf("config.upload job %d failed: %w", jobID, err)
+		return nil, fmt.Errorf("config.upload job %d failed: %w", jobID, err)
 	}
 
 	log.Printf("[truenas] Config restored successfully (job %d). TrueNAS will reboot shortly.", jobID)
-	return nil
+	return plan, nil
+}
+
+// listConfigArchive reports the members of a config backup. config.save
+// produces either a tar archive or, without options, a bare SQLite file.
+func listConfigArchive(r io.Reader) ([]backup.FileChange, error) {
+	br := bufio.NewReader(r)
+	header, _ := br.Peek(262)
+	if len(header) < 262 || string(header[257:262]) != "ustar" {
+		n, err := io.Copy(io.Discard, br)
+		if err != nil {
+			return nil, fmt.Errorf("read backup: %w", err)
+		}
+		return []backup.FileChange{{Path: "freenas-v1.db", Action: backup.FileOverwrite, Size: n}}, nil
+	}
+
+	var files []backup.FileChange
+	tr := tar.NewReader(br)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read config tar: %w", err)
+		}
+		if hdr.Typeflag == tar.TypeReg {
+			files = append(files, backup.FileChange{Path: hdr.Name, Action: backup.FileOverwrite, Size: hdr.Size})
+		}
+	}
 }
 
 // jobInfo represents a single job entry from core.get_jobs.
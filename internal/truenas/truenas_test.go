@@ -1,6 +1,7 @@
 package truenas
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -11,6 +12,8 @@ import (
 	"testing"
 
 	"github.com/gorilla/websocket"
+
+	"backuparr/internal/backup"
 )
 
 func TestWSURL(t *testing.T) {
@@ -45,7 +48,7 @@ func TestRestore(t *testing.T) {
 	defer mock.close()
 
 	c := NewClient(mock.server.URL, "valid-api-key")
-	err := c.Restore(context.Background(), bytes.NewReader(restoreData))
+	_, err := c.Restore(context.Background(), bytes.NewReader(restoreData), backup.RestoreOptions{})
 	if err != nil {
 		t.Fatalf("Restore() error: %v", err)
 	}
@@ -59,12 +62,37 @@ func TestRestore(t *testing.T) {
 	}
 }
 
+func TestRestore_DryRun(t *testing.T) {
+	mock := newMockTrueNAS("valid-api-key", nil)
+	defer mock.close()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, data := range map[string]string{"freenas-v1.db": "db", "pwenc_secret": "secret"} {
+		tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(data)), Typeflag: tar.TypeReg})
+		tw.Write([]byte(data))
+	}
+	tw.Close()
+
+	c := NewClient(mock.server.URL, "valid-api-key")
+	plan, err := c.Restore(context.Background(), &buf, backup.RestoreOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("Restore() error: %v", err)
+	}
+	if mock.uploadedData != nil {
+		t.Error("dry run uploaded the config")
+	}
+	if len(plan.Files) != 2 || plan.RestartMethod != "reboot" {
+		t.Errorf("plan = %+v, want 2 files and reboot", plan)
+	}
+}
+
 func TestRestore_AuthFailure(t *testing.T) {
 	mock := newMockTrueNAS("valid-api-key", nil)
 	defer mock.close()
 
 	c := NewClient(mock.server.URL, "wrong-key")
-	err := c.Restore(context.Background(), strings.NewReader("data"))
+	_, err := c.Restore(context.Background(), strings.NewReader("data"), backup.RestoreOptions{})
 	if err == nil {
 		t.Fatal("Restore() should fail with wrong API key")
 	}
@@ -81,7 +109,7 @@ func TestRestore_JobFailure(t *testing.T) {
 	defer mock.close()
 
 	c := NewClient(mock.server.URL, "valid-api-key")
-	err := c.Restore(context.Background(), strings.NewReader("data"))
+	_, err := c.Restore(context.Background(), strings.NewReader("data"), backup.RestoreOptions{})
 	if err == nil {
 		t.Fatal("Restore() should fail when job fails")
 	}
@@ -0,0 +1,400 @@
+// Package verify validates a stored backup end-to-end without restoring it:
+// checksum against the manifest, archive structure, expected members for the
+// app type, and SQLite integrity of any embedded databases.
+package verify
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	_ "modernc.org/sqlite" // registers the "sqlite" database/sql driver
+
+	"backuparr/internal/backup"
+	"backuparr/internal/storage"
+)
+
+// Check status values.
+const (
+	StatusOK      = "ok"
+	StatusFailed  = "failed"
+	StatusSkipped = "skipped"
+)
+
+// Check is the outcome of a single verification step.
+type Check struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// Report summarises the verification of one backup.
+type Report struct {
+	Format string  `json:"format"` // "zip", "tar" or "" if unrecognised
+	Size   int64   `json:"size"`
+	SHA256 string  `json:"sha256"`
+	Checks []Check `json:"checks"`
+}
+
+// OK reports whether no check failed.
+func (r *Report) OK() bool {
+	for _, c := range r.Checks {
+		if c.Status == StatusFailed {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Report) pass(name, detail string) {
+	r.Checks = append(r.Checks, Check{Name: name, Status: StatusOK, Detail: detail})
+}
+
+func (r *Report) fail(name, detail string) {
+	r.Checks = append(r.Checks, Check{Name: name, Status: StatusFailed, Detail: detail})
+}
+
+func (r *Report) skip(name, detail string) {
+	r.Checks = append(r.Checks, Check{Name: name, Status: StatusSkipped, Detail: detail})
+}
+
+// sqliteMagic is the first 16 bytes of every SQLite database file.
+var sqliteMagic = []byte("SQLite format 3\000")
+
+// pgDumpComplete is the trailer pg_dump writes at the end of a plain-format
+// dump. Its absence means the dump was truncated.
+const pgDumpComplete = "-- PostgreSQL database dump complete"
+
+// mysqlDumpComplete starts the trailer line mysqldump writes at the end of
+// a dump (followed by the date unless --skip-dump-date is used).
+const mysqlDumpComplete = "-- Dump completed"
+
+// pgCustomMagic starts every custom-format pg_dump archive.
+var pgCustomMagic = []byte("PGDMP")
+
+// Verify reads a (decrypted) backup archive and checks it. appType selects
+// the expected archive members; m is the backup's manifest, or nil if it
+// has none. The archive is spooled to a temporary file for random access.
+//
+// An error is returned only if verification itself could not run (e.g. the
+// temp directory is not writable); problems with the backup are reported as
+// failed checks.
+func Verify(ctx context.Context, r io.Reader, appType string, m *storage.Manifest) (*Report, error) {
+	hasher := storage.NewHasher(r)
+	spool, err := backup.Spool(hasher, "backuparr-verify-*")
+	if err != nil {
+		return nil, err
+	}
+	defer spool.Close()
+
+	report := &Report{Size: hasher.Size(), SHA256: hasher.Sum()}
+
+	switch {
+	case m == nil:
+		report.skip("checksum", "no manifest")
+	case m.SHA256 != report.SHA256 || m.Size != report.Size:
+		report.fail("checksum", fmt.Sprintf("got sha256 %s (%d bytes), manifest has %s (%d bytes)", report.SHA256, report.Size, m.SHA256, m.Size))
+	default:
+		report.pass("checksum", report.SHA256)
+	}
+
+	header := make([]byte, 512)
+	n, _ := io.ReadFull(spool, header)
+	header = header[:n]
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to rewind spooled backup: %w", err)
+	}
+
+	var members []member
+	switch {
+	case bytes.HasPrefix(header, []byte("PK\x03\x04")):
+		report.Format = "zip"
+		members = verifyZip(ctx, report, spool, spool.Size())
+	case isTar(header):
+		report.Format = "tar"
+		members = verifyTar(ctx, report, spool)
+	default:
+		report.fail("archive", "not a zip or tar archive")
+		return report, nil
+	}
+
+	checkExpectedMembers(report, appType, members)
+	return report, nil
+}
+
+// member records what was learned about one archive entry while reading it.
+type member struct {
+	name      string
+	sqlite    bool
+	pgDump    bool
+	mysqlDump bool
+	complete  bool // dump trailer present
+}
+
+func isTar(header []byte) bool {
+	// POSIX/GNU tar headers carry "ustar" at offset 257.
+	return len(header) >= 262 && bytes.Equal(header[257:262], []byte("ustar"))
+}
+
+func verifyZip(ctx context.Context, report *Report, r io.ReaderAt, size int64) []member {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		report.fail("archive", fmt.Sprintf("failed to open zip: %v", err))
+		return nil
+	}
+
+	var members []member
+	failed := false
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			report.fail("archive", fmt.Sprintf("failed to open %s: %v", f.Name, err))
+			failed = true
+			continue
+		}
+		// Reading to EOF makes archive/zip verify the entry's CRC-32.
+		m, err := inspectMember(ctx, report, f.Name, rc)
+		rc.Close()
+		if err != nil {
+			report.fail("archive", fmt.Sprintf("failed to read %s: %v", f.Name, err))
+			failed = true
+			continue
+		}
+		members = append(members, m)
+	}
+	if !failed {
+		report.pass("archive", fmt.Sprintf("%d entries", len(zr.File)))
+	}
+	return members
+}
+
+func verifyTar(ctx context.Context, report *Report, r io.Reader) []member {
+	tr := tar.NewReader(r)
+	var members []member
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			report.fail("archive", fmt.Sprintf("failed to read tar: %v", err))
+			return members
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		m, err := inspectMember(ctx, report, hdr.Name, tr)
+		if err != nil {
+			report.fail("archive", fmt.Sprintf("failed to read %s: %v", hdr.Name, err))
+			return members
+		}
+		members = append(members, m)
+	}
+	report.pass("archive", fmt.Sprintf("%d entries", len(members)))
+	return members
+}
+
+// inspectMember reads one archive entry to the end, running the SQLite or
+// dump checks that apply to it.
+func inspectMember(ctx context.Context, report *Report, name string, r io.Reader) (member, error) {
+	m := member{name: name}
+	br := bufio.NewReader(r)
+	head, _ := br.Peek(len(sqliteMagic))
+
+	switch {
+	case bytes.Equal(head, sqliteMagic):
+		m.sqlite = true
+		return m, checkSQLite(ctx, report, name, br)
+	case strings.HasPrefix(name, "postgres/") && strings.HasSuffix(name, ".sql"):
+		m.pgDump = true
+		tail, err := readTail(br, len(pgDumpComplete)+64)
+		if err != nil {
+			return m, err
+		}
+		m.complete = bytes.Contains(tail, []byte(pgDumpComplete))
+		if m.complete {
+			report.pass("pg_dump "+name, "")
+		} else {
+			report.fail("pg_dump "+name, "dump is truncated (missing completion trailer)")
+		}
+		return m, nil
+	case strings.HasPrefix(name, "mysql/") && strings.HasSuffix(name, ".sql"):
+		m.mysqlDump = true
+		tail, err := readTail(br, 128)
+		if err != nil {
+			return m, err
+		}
+		m.complete = bytes.Contains(tail, []byte(mysqlDumpComplete))
+		if m.complete {
+			report.pass("mysqldump "+name, "")
+		} else {
+			report.fail("mysqldump "+name, "dump is truncated (missing completion trailer)")
+		}
+		return m, nil
+	case strings.HasPrefix(name, "postgres/") && strings.HasSuffix(name, ".dump"):
+		// Custom-format archives have no trailer, so only the header can be
+		// checked here; pg_restore reports truncated data on restore.
+		m.pgDump = true
+		m.complete = bytes.HasPrefix(head, pgCustomMagic)
+		if m.complete {
+			report.pass("pg_dump "+name, "custom format")
+		} else {
+			report.fail("pg_dump "+name, "not a custom-format pg_dump archive")
+		}
+		_, err := io.Copy(io.Discard, br)
+		return m, err
+	default:
+		_, err := io.Copy(io.Discard, br)
+		return m, err
+	}
+}
+
+// readTail reads r to EOF and returns the last n bytes.
+func readTail(r io.Reader, n int) ([]byte, error) {
+	buf := make([]byte, 32*1024)
+	var tail []byte
+	for {
+		k, err := r.Read(buf)
+		tail = append(tail, buf[:k]...)
+		if len(tail) > n {
+			tail = tail[len(tail)-n:]
+		}
+		if err == io.EOF {
+			return tail, nil
+		}
+		if err != nil {
+			return tail, err
+		}
+	}
+}
+
+// checkSQLite extracts an embedded database and runs PRAGMA integrity_check.
+func checkSQLite(ctx context.Context, report *Report, name string, r io.Reader) error {
+	spool, err := backup.Spool(r, "backuparr-verify-*.db")
+	if err != nil {
+		return err
+	}
+	defer spool.Close()
+
+	check := "sqlite " + name
+	db, err := sql.Open("sqlite", "file:"+spool.Name()+"?mode=ro&immutable=1")
+	if err != nil {
+		report.fail(check, err.Error())
+		return nil
+	}
+	defer db.Close()
+
+	rows, err := db.QueryContext(ctx, "PRAGMA integrity_check")
+	if err != nil {
+		report.fail(check, err.Error())
+		return nil
+	}
+	defer rows.Close()
+
+	var problems []string
+	for rows.Next() {
+		var line string
+		if err := rows.Scan(&line); err != nil {
+			report.fail(check, err.Error())
+			return nil
+		}
+		if line != "ok" {
+			problems = append(problems, line)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		report.fail(check, err.Error())
+		return nil
+	}
+
+	if len(problems) > 0 {
+		report.fail(check, strings.Join(problems, "; "))
+	} else {
+		report.pass(check, "integrity_check ok")
+	}
+	return nil
+}
+
+// checkExpectedMembers confirms the archive contains what a restore of
+// appType needs.
+func checkExpectedMembers(report *Report, appType string, members []member) {
+	has := func(match func(member) bool) bool {
+		for _, m := range members {
+			if match(m) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch appType {
+	case "sonarr", "radarr", "lidarr", "readarr", "prowlarr":
+		if has(func(m member) bool { return path.Base(m.name) == "config.xml" }) {
+			report.pass("member config.xml", "")
+		} else {
+			report.fail("member config.xml", "missing")
+		}
+		// SQLite instances ship <app>.db; Postgres instances ship dumps
+		// under postgres/ instead.
+		dbName := appType + ".db"
+		switch {
+		case has(func(m member) bool { return path.Base(m.name) == dbName && m.sqlite }):
+			report.pass("member "+dbName, "")
+		case has(func(m member) bool { return m.pgDump }):
+			report.pass("member postgres dumps", "")
+		default:
+			report.fail("member database", fmt.Sprintf("neither %s nor postgres/ dumps found", dbName))
+		}
+	case "postgres":
+		if has(func(m member) bool { return m.pgDump }) {
+			report.pass("member postgres dumps", "")
+		} else {
+			report.fail("member postgres dumps", "no postgres/ dumps found")
+		}
+	case "mysql":
+		if has(func(m member) bool { return m.mysqlDump }) {
+			report.pass("member mysql dumps", "")
+		} else {
+			report.fail("member mysql dumps", "no mysql/ dumps found")
+		}
+	case "jellyfin", "emby":
+		// Backup API archives carry a manifest.json; data directory
+		// archives carry the SQLite databases themselves.
+		switch {
+		case has(func(m member) bool { return m.name == "manifest.json" }):
+			report.pass("member manifest.json", "")
+		case has(func(m member) bool { return m.sqlite }):
+			report.pass("member databases", "")
+		default:
+			report.fail("member database", "neither manifest.json nor SQLite databases found")
+		}
+	case "homeassistant":
+		if has(func(m member) bool { return strings.TrimPrefix(m.name, "./") == "backup.json" }) {
+			report.pass("member backup.json", "")
+		} else {
+			report.fail("member backup.json", "missing")
+		}
+	case "truenas":
+		if has(func(m member) bool { return path.Base(m.name) == "freenas-v1.db" && m.sqlite }) {
+			report.pass("member freenas-v1.db", "")
+		} else {
+			report.fail("member freenas-v1.db", "missing")
+		}
+	default:
+		if len(members) == 0 {
+			report.fail("members", "archive is empty")
+		} else {
+			report.skip("members", fmt.Sprintf("no expected members defined for %q", appType))
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package verify
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"backuparr/internal/storage"
+)
+
+// sqliteDB returns the bytes of a small valid SQLite database.
+func sqliteDB(t *testing.T) []byte {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "test.db")
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range []string{
+		"CREATE TABLE Series (Id INTEGER PRIMARY KEY, Title TEXT)",
+		"CREATE INDEX IX_Series_Title ON Series (Title)",
+		"INSERT INTO Series (Title) VALUES ('a'), ('b'), ('c')",
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Close()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func makeZip(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, data := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(data)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func manifestFor(data []byte) *storage.Manifest {
+	sum := sha256.Sum256(data)
+	return &storage.Manifest{Size: int64(len(data)), SHA256: hex.EncodeToString(sum[:])}
+}
+
+func failedChecks(r *Report) []Check {
+	var failed []Check
+	for _, c := range r.Checks {
+		if c.Status == StatusFailed {
+			failed = append(failed, c)
+		}
+	}
+	return failed
+}
+
+func TestVerify_ValidSonarrBackup(t *testing.T) {
+	data := makeZip(t, map[string][]byte{
+		"config.xml": []byte("<Config></Config>"),
+		"sonarr.db":  sqliteDB(t),
+	})
+
+	report, err := Verify(context.Background(), bytes.NewReader(data), "sonarr", manifestFor(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.OK() || report.Format != "zip" {
+		t.Errorf("report = %+v, want OK zip", report)
+	}
+}
+
+func TestVerify_PostgresBackup(t *testing.T) {
+	complete := []byte("--\n-- PostgreSQL database dump\n--\nCREATE TABLE x();\n--\n-- PostgreSQL database dump complete\n--\n")
+	truncated := complete[:40]
+
+	ok := makeZip(t, map[string][]byte{
+		"config.xml":               []byte("<Config></Config>"),
+		"postgres/sonarr_main.sql": complete,
+	})
+	report, err := Verify(context.Background(), bytes.NewReader(ok), "sonarr", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.OK() {
+		t.Errorf("complete dump failed: %+v", failedChecks(report))
+	}
+
+	bad := makeZip(t, map[string][]byte{
+		"config.xml":               []byte("<Config></Config>"),
+		"postgres/sonarr_main.sql": truncated,
+	})
+	report, err = Verify(context.Background(), bytes.NewReader(bad), "sonarr", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.OK() {
+		t.Error("truncated dump passed verification")
+	}
+
+	custom := makeZip(t, map[string][]byte{
+		"config.xml":                []byte("<Config></Config>"),
+		"postgres/sonarr_main.dump": []byte("PGDMP\x01\x10\x00\x04\x08\x08\x01\x01"),
+	})
+	report, err = Verify(context.Background(), bytes.NewReader(custom), "sonarr", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.OK() {
+		t.Errorf("custom-format dump failed: %+v", failedChecks(report))
+	}
+}
+
+func TestVerify_DatabaseAppTypes(t *testing.T) {
+	pgDump := []byte("CREATE TABLE x();\n--\n-- PostgreSQL database dump complete\n--\n")
+	mysqlDump := []byte("CREATE TABLE `x` (id int);\n-- Dump completed on 2026-02-06  3:00:00\n")
+
+	tests := []struct {
+		appType string
+		files   map[string][]byte
+		ok      bool
+	}{
+		{"postgres", map[string][]byte{"postgres/authentik.sql": pgDump}, true},
+		{"postgres", map[string][]byte{"mysql/nextcloud.sql": mysqlDump}, false},
+		{"mysql", map[string][]byte{"mysql/nextcloud.sql": mysqlDump}, true},
+		{"mysql", map[string][]byte{"mysql/nextcloud.sql": mysqlDump[:20]}, false},
+	}
+	for _, tt := range tests {
+		data := makeZip(t, tt.files)
+		report, err := Verify(context.Background(), bytes.NewReader(data), tt.appType, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if report.OK() != tt.ok {
+			t.Errorf("%s %v: OK = %v, want %v (%+v)", tt.appType, tt.files, report.OK(), tt.ok, report.Checks)
+		}
+	}
+}
+
+func TestVerify_Failures(t *testing.T) {
+	db := sqliteDB(t)
+	corruptDB := append([]byte{}, db...)
+	// Clobber the b-tree page header of the second page (the Series table).
+	for i := 4096; i < 4096+8; i++ {
+		corruptDB[i] = 0xff
+	}
+
+	valid := makeZip(t, map[string][]byte{"config.xml": []byte("<Config/>"), "sonarr.db": db})
+
+	tests := []struct {
+		name     string
+		data     []byte
+		manifest *storage.Manifest
+	}{
+		{"checksum mismatch", valid, &storage.Manifest{Size: int64(len(valid)), SHA256: "deadbeef"}},
+		{"truncated zip", valid[:len(valid)/2], nil},
+		{"not an archive", []byte("hello"), nil},
+		{"missing config.xml", makeZip(t, map[string][]byte{"sonarr.db": db}), nil},
+		{"missing database", makeZip(t, map[string][]byte{"config.xml": []byte("<Config/>")}), nil},
+		{"corrupt sqlite", makeZip(t, map[string][]byte{"config.xml": []byte("<Config/>"), "sonarr.db": corruptDB}), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := Verify(context.Background(), bytes.NewReader(tt.data), "sonarr", tt.manifest)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if report.OK() {
+				t.Errorf("expected verification failure, got %+v", report.Checks)
+			}
+		})
+	}
+}
+
+func TestVerify_TrueNASTar(t *testing.T) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, data := range map[string][]byte{
+		"freenas-v1.db": sqliteDB(t),
+		"pwenc_secret":  []byte("secret"),
+	} {
+		tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(data)), Typeflag: tar.TypeReg, Format: tar.FormatPAX})
+		tw.Write(data)
+	}
+	tw.Close()
+
+	report, err := Verify(context.Background(), bytes.NewReader(buf.Bytes()), "truenas", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.OK() || report.Format != "tar" {
+		t.Errorf("report = %+v, want OK tar", report)
+	}
+}
@@ -0,0 +1,357 @@
+// Package webauth authenticates and authorises requests to the backuparr web
+// UI and API. Requests are identified by a static API key, HTTP basic auth
+// or headers set by a trusted forward-auth proxy, and granted a read-only or
+// admin role.
+package webauth
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"backuparr/internal/config"
+)
+
+// Role is an access level. Higher roles include the lower ones.
+type Role int
+
+const (
+	RoleNone Role = iota
+	RoleRead
+	RoleAdmin
+)
+
+func (r Role) String() string {
+	switch r {
+	case RoleRead:
+		return config.RoleRead
+	case RoleAdmin:
+		return config.RoleAdmin
+	default:
+		return "none"
+	}
+}
+
+// MarshalText renders the role as its config name.
+func (r Role) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// parseRole converts a config role name; empty yields def.
+func parseRole(s string, def Role) (Role, error) {
+	switch s {
+	case "":
+		return def, nil
+	case config.RoleRead:
+		return RoleRead, nil
+	case config.RoleAdmin:
+		return RoleAdmin, nil
+	default:
+		return RoleNone, fmt.Errorf("invalid role %q (want %q or %q)", s, config.RoleRead, config.RoleAdmin)
+	}
+}
+
+// Identity is the authenticated principal behind a request.
+type Identity struct {
+	Name   string `json:"name"`
+	Role   Role   `json:"role"`
+	Method string `json:"method"` // "apikey", "basic", "forward", "anonymous" or "none"
+}
+
+// APIKeyHeader carries a static API key.
+const APIKeyHeader = "X-Api-Key"
+
+type apiKey struct {
+	name string
+	key  []byte
+	role Role
+}
+
+type user struct {
+	hash []byte
+	role Role
+}
+
+type forwardAuth struct {
+	proxies      []netip.Prefix
+	userHeader   string
+	groupsHeader string
+	adminUsers   []string
+	adminGroups  []string
+	defaultRole  Role
+}
+
+// Authenticator checks requests against the configured auth methods.
+type Authenticator struct {
+	enabled   bool
+	keys      []apiKey
+	users     map[string]user
+	forward   *forwardAuth
+	anonymous Role
+	origins   map[string]bool
+}
+
+// New builds an Authenticator from the web config. With no auth method
+// configured every request is granted RoleAdmin, matching the behaviour of
+// earlier releases.
+func New(cfg config.WebConfig) (*Authenticator, error) {
+	ac := cfg.Auth
+	a := &Authenticator{
+		enabled: ac.Enabled(),
+		users:   map[string]user{},
+		origins: map[string]bool{},
+	}
+
+	for _, o := range cfg.AllowedOrigins {
+		a.origins[strings.TrimRight(strings.ToLower(o), "/")] = true
+	}
+
+	var err error
+	if a.anonymous, err = parseRole(ac.AnonymousRole, RoleNone); err != nil {
+		return nil, fmt.Errorf("anonymousRole: %w", err)
+	}
+
+	for i, k := range ac.APIKeys {
+		if k.Key == "" {
+			return nil, fmt.Errorf("apiKeys[%d]: key is required", i)
+		}
+		role, err := parseRole(k.Role, RoleAdmin)
+		if err != nil {
+			return nil, fmt.Errorf("apiKeys[%d]: %w", i, err)
+		}
+		name := k.Name
+		if name == "" {
+			name = "api-key"
+		}
+		a.keys = append(a.keys, apiKey{name: name, key: []byte(k.Key), role: role})
+	}
+
+	for i, u := range ac.Users {
+		if u.Username == "" || u.PasswordHash == "" {
+			return nil, fmt.Errorf("users[%d]: username and passwordHash are required", i)
+		}
+		if _, err := bcrypt.Cost([]byte(u.PasswordHash)); err != nil {
+			return nil, fmt.Errorf("users[%d]: passwordHash is not a bcrypt hash: %w", i, err)
+		}
+		role, err := parseRole(u.Role, RoleAdmin)
+		if err != nil {
+			return nil, fmt.Errorf("users[%d]: %w", i, err)
+		}
+		a.users[u.Username] = user{hash: []byte(u.PasswordHash), role: role}
+	}
+
+	if fc := ac.ForwardAuth; fc != nil {
+		if len(fc.TrustedProxies) == 0 {
+			return nil, fmt.Errorf("forwardAuth: trustedProxies is required")
+		}
+		f := &forwardAuth{
+			userHeader:   fc.UserHeader,
+			groupsHeader: fc.GroupsHeader,
+			adminUsers:   fc.AdminUsers,
+			adminGroups:  fc.AdminGroups,
+		}
+		if f.userHeader == "" {
+			f.userHeader = "Remote-User"
+		}
+		if f.groupsHeader == "" {
+			f.groupsHeader = "Remote-Groups"
+		}
+		if f.defaultRole, err = parseRole(fc.DefaultRole, RoleRead); err != nil {
+			return nil, fmt.Errorf("forwardAuth: defaultRole: %w", err)
+		}
+		for _, p := range fc.TrustedProxies {
+			prefix, err := parsePrefix(p)
+			if err != nil {
+				return nil, fmt.Errorf("forwardAuth: invalid trusted proxy %q: %w", p, err)
+			}
+			f.proxies = append(f.proxies, prefix)
+		}
+		a.forward = f
+	}
+
+	return a, nil
+}
+
+// Enabled reports whether any auth method is configured.
+func (a *Authenticator) Enabled() bool {
+	return a.enabled
+}
+
+func parsePrefix(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		return netip.ParsePrefix(s)
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// Authenticate identifies the caller. Invalid credentials are an error;
+// a request without credentials yields the anonymous identity.
+func (a *Authenticator) Authenticate(r *http.Request) (Identity, error) {
+	if !a.enabled {
+		return Identity{Name: "anonymous", Role: RoleAdmin, Method: "none"}, nil
+	}
+
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		for _, k := range a.keys {
+			if subtle.ConstantTimeCompare([]byte(key), k.key) == 1 {
+				return Identity{Name: k.name, Role: k.role, Method: "apikey"}, nil
+			}
+		}
+		return Identity{}, fmt.Errorf("invalid API key")
+	}
+
+	if username, password, ok := r.BasicAuth(); ok && len(a.users) > 0 {
+		u, found := a.users[username]
+		if !found || bcrypt.CompareHashAndPassword(u.hash, []byte(password)) != nil {
+			return Identity{}, fmt.Errorf("invalid username or password")
+		}
+		return Identity{Name: username, Role: u.role, Method: "basic"}, nil
+	}
+
+	if f := a.forward; f != nil && f.trusts(r) {
+		if name := r.Header.Get(f.userHeader); name != "" {
+			return Identity{Name: name, Role: f.role(name, r.Header.Get(f.groupsHeader)), Method: "forward"}, nil
+		}
+	}
+
+	return Identity{Name: "anonymous", Role: a.anonymous, Method: "anonymous"}, nil
+}
+
+// trusts reports whether the request came directly from a trusted proxy.
+func (f *forwardAuth) trusts(r *http.Request) bool {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range f.proxies {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *forwardAuth) role(name, groups string) Role {
+	if slices.Contains(f.adminUsers, name) {
+		return RoleAdmin
+	}
+	for _, g := range strings.Split(groups, ",") {
+		if slices.Contains(f.adminGroups, strings.TrimSpace(g)) {
+			return RoleAdmin
+		}
+	}
+	return f.defaultRole
+}
+
+// CheckOrigin reports whether a browser request may act on the API. Requests
+// without an Origin header (curl, scripts) are allowed; browser requests must
+// come from the UI's own host or a configured allowed origin. It is suitable
+// as a websocket.Upgrader CheckOrigin func.
+func (a *Authenticator) CheckOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+	return a.origins[strings.TrimRight(strings.ToLower(origin), "/")]
+}
+
+type ctxKey struct{}
+
+// FromContext returns the identity stored by Require, if any.
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(ctxKey{}).(Identity)
+	return id, ok
+}
+
+// Require wraps next so it only runs for callers with at least role.
+// Unsafe methods additionally require an allowed Origin to stop cross-site
+// requests riding on a browser's cached basic auth credentials.
+func (a *Authenticator) Require(role Role, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := a.Authenticate(r)
+		if err != nil {
+			log.Printf("Web auth: rejected request from %s: %v", r.RemoteAddr, err)
+			a.unauthorized(w)
+			return
+		}
+		if id.Role < role {
+			if id.Method == "anonymous" {
+				a.unauthorized(w)
+				return
+			}
+			writeError(w, http.StatusForbidden, fmt.Sprintf("%s role required", role))
+			return
+		}
+		if !isSafeMethod(r.Method) && !a.CheckOrigin(r) {
+			writeError(w, http.StatusForbidden, "cross-origin request rejected")
+			return
+		}
+		next(w, r.WithContext(context.WithValue(r.Context(), ctxKey{}, id)))
+	}
+}
+
+// RequireRead requires RoleRead.
+func (a *Authenticator) RequireRead(next http.HandlerFunc) http.HandlerFunc {
+	return a.Require(RoleRead, next)
+}
+
+// RequireAdmin requires RoleAdmin.
+func (a *Authenticator) RequireAdmin(next http.HandlerFunc) http.HandlerFunc {
+	return a.Require(RoleAdmin, next)
+}
+
+// RequireAdminToWrite requires RoleRead for GET and HEAD requests and
+// RoleAdmin for everything else, for endpoints that both list and modify.
+func (a *Authenticator) RequireAdminToWrite(next http.HandlerFunc) http.HandlerFunc {
+	read, admin := a.RequireRead(next), a.RequireAdmin(next)
+	return func(w http.ResponseWriter, r *http.Request) {
+		if isSafeMethod(r.Method) {
+			read(w, r)
+		} else {
+			admin(w, r)
+		}
+	}
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+func (a *Authenticator) unauthorized(w http.ResponseWriter) {
+	if len(a.users) > 0 {
+		w.Header().Set("WWW-Authenticate", `Basic realm="backuparr", charset="UTF-8"`)
+	}
+	writeError(w, http.StatusUnauthorized, "authentication required")
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
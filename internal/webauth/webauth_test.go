@@ -0,0 +1,175 @@
+package webauth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"backuparr/internal/config"
+)
+
+func newTestAuth(t *testing.T) *Authenticator {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte("hunter2"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := New(config.WebConfig{
+		Auth: config.AuthConfig{
+			APIKeys: []config.APIKeyConfig{
+				{Name: "grafana", Key: "read-key", Role: "read"},
+				{Key: "admin-key"},
+			},
+			Users: []config.UserConfig{{Username: "alice", PasswordHash: string(hash)}},
+			ForwardAuth: &config.ForwardAuthConfig{
+				TrustedProxies: []string{"10.0.0.0/8"},
+				AdminGroups:    []string{"admins"},
+			},
+			AnonymousRole: "read",
+		},
+		AllowedOrigins: []string{"https://backups.example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func TestAuthenticate(t *testing.T) {
+	a := newTestAuth(t)
+
+	tests := []struct {
+		name    string
+		setup   func(r *http.Request)
+		want    Identity
+		wantErr bool
+	}{
+		{"anonymous", func(r *http.Request) {}, Identity{Name: "anonymous", Role: RoleRead, Method: "anonymous"}, false},
+		{"read key", func(r *http.Request) { r.Header.Set(APIKeyHeader, "read-key") }, Identity{Name: "grafana", Role: RoleRead, Method: "apikey"}, false},
+		{"admin key", func(r *http.Request) { r.Header.Set(APIKeyHeader, "admin-key") }, Identity{Name: "api-key", Role: RoleAdmin, Method: "apikey"}, false},
+		{"bad key", func(r *http.Request) { r.Header.Set(APIKeyHeader, "nope") }, Identity{}, true},
+		{"basic", func(r *http.Request) { r.SetBasicAuth("alice", "hunter2") }, Identity{Name: "alice", Role: RoleAdmin, Method: "basic"}, false},
+		{"basic wrong password", func(r *http.Request) { r.SetBasicAuth("alice", "wrong") }, Identity{}, true},
+		{"basic unknown user", func(r *http.Request) { r.SetBasicAuth("bob", "hunter2") }, Identity{}, true},
+		{"forward admin group", func(r *http.Request) {
+			r.RemoteAddr = "10.1.2.3:5555"
+			r.Header.Set("Remote-User", "carol")
+			r.Header.Set("Remote-Groups", "users, admins")
+		}, Identity{Name: "carol", Role: RoleAdmin, Method: "forward"}, false},
+		{"forward default role", func(r *http.Request) {
+			r.RemoteAddr = "10.1.2.3:5555"
+			r.Header.Set("Remote-User", "dave")
+		}, Identity{Name: "dave", Role: RoleRead, Method: "forward"}, false},
+		{"forward from untrusted address", func(r *http.Request) {
+			r.RemoteAddr = "192.168.1.10:5555"
+			r.Header.Set("Remote-User", "mallory")
+			r.Header.Set("Remote-Groups", "admins")
+		}, Identity{Name: "anonymous", Role: RoleRead, Method: "anonymous"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/apps", nil)
+			tt.setup(r)
+			got, err := a.Authenticate(r)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequire(t *testing.T) {
+	a := newTestAuth(t)
+	ok := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) }
+	handler := a.RequireAdminToWrite(ok)
+
+	tests := []struct {
+		name   string
+		method string
+		key    string
+		origin string
+		want   int
+	}{
+		{"anonymous read", http.MethodGet, "", "", http.StatusNoContent},
+		{"anonymous delete", http.MethodDelete, "", "", http.StatusUnauthorized},
+		{"read-only delete", http.MethodDelete, "read-key", "", http.StatusForbidden},
+		{"admin delete", http.MethodDelete, "admin-key", "", http.StatusNoContent},
+		{"admin same origin", http.MethodPost, "admin-key", "http://example.com", http.StatusNoContent},
+		{"admin allowed origin", http.MethodPost, "admin-key", "https://backups.example.com", http.StatusNoContent},
+		{"admin cross origin", http.MethodPost, "admin-key", "https://evil.example", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, "http://example.com/api/backups", nil)
+			if tt.key != "" {
+				r.Header.Set(APIKeyHeader, tt.key)
+			}
+			if tt.origin != "" {
+				r.Header.Set("Origin", tt.origin)
+			}
+			rec := httptest.NewRecorder()
+			handler(rec, r)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d (%s)", rec.Code, tt.want, rec.Body)
+			}
+		})
+	}
+}
+
+func TestUnauthorizedChallengesBasicAuth(t *testing.T) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("pw"), bcrypt.MinCost)
+	a, err := New(config.WebConfig{Auth: config.AuthConfig{
+		Users: []config.UserConfig{{Username: "alice", PasswordHash: string(hash), Role: "read"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := httptest.NewRecorder()
+	a.RequireRead(func(http.ResponseWriter, *http.Request) {})(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusUnauthorized || rec.Header().Get("WWW-Authenticate") == "" {
+		t.Errorf("status = %d, WWW-Authenticate = %q; want 401 with a basic challenge", rec.Code, rec.Header().Get("WWW-Authenticate"))
+	}
+}
+
+func TestNoAuthConfigured(t *testing.T) {
+	a, err := New(config.WebConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := a.Authenticate(httptest.NewRequest(http.MethodDelete, "/api/backups", nil))
+	if err != nil || id.Role != RoleAdmin {
+		t.Errorf("got %+v, %v; want admin when auth is not configured", id, err)
+	}
+}
+
+func TestNew_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.AuthConfig
+	}{
+		{"empty key", config.AuthConfig{APIKeys: []config.APIKeyConfig{{Name: "x"}}}},
+		{"bad role", config.AuthConfig{APIKeys: []config.APIKeyConfig{{Key: "k", Role: "owner"}}}},
+		{"plaintext password", config.AuthConfig{Users: []config.UserConfig{{Username: "a", PasswordHash: "hunter2"}}}},
+		{"forward without proxies", config.AuthConfig{ForwardAuth: &config.ForwardAuthConfig{}}},
+		{"bad proxy", config.AuthConfig{ForwardAuth: &config.ForwardAuthConfig{TrustedProxies: []string{"proxy.local"}}}},
+		{"bad anonymous role", config.AuthConfig{AnonymousRole: "guest"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(config.WebConfig{Auth: tt.cfg}); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}